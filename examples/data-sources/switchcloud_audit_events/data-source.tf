@@ -0,0 +1,11 @@
+# Membership changes of a project during the last audit period
+data "switchcloud_audit_events" "example" {
+  project_id = switchcloud_project.example.id
+  start_time = "2025-01-01T00:00:00Z"
+  end_time   = "2026-01-01T00:00:00Z"
+  event_type = "project.member_added"
+}
+
+output "added_members" {
+  value = [for e in data.switchcloud_audit_events.example.events : "${e.occurred_at} ${e.actor_email} added ${e.details["email"]}"]
+}
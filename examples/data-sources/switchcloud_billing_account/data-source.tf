@@ -0,0 +1,8 @@
+data "switchcloud_billing_account" "example" {
+  cost_centre = "1234-5678"
+}
+
+resource "switchcloud_project" "example" {
+  name               = "my-project"
+  billing_account_id = data.switchcloud_billing_account.example.id
+}
@@ -0,0 +1,7 @@
+data "switchcloud_organisation_members" "admins" {
+  role = "admin"
+}
+
+output "admin_emails" {
+  value = data.switchcloud_organisation_members.admins.members[*].email
+}
@@ -0,0 +1,9 @@
+data "switchcloud_project_usage" "example" {
+  project_id = switchcloud_project.example.id
+  start_date = "2025-01-01"
+  end_date   = "2025-03-31"
+}
+
+output "compute_cost" {
+  value = sum([for item in data.switchcloud_project_usage.example.usage : item.cost if item.service == "compute"])
+}
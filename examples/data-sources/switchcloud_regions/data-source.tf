@@ -0,0 +1,15 @@
+data "switchcloud_regions" "example" {}
+
+locals {
+  # Keystone auth URLs of all available regions, keyed by region identifier
+  auth_urls = {
+    for region in data.switchcloud_regions.example.regions : region.id => region.auth_url
+    if region.status == "available"
+  }
+}
+
+provider "openstack" {
+  alias    = "zh"
+  auth_url = local.auth_urls["ZH"]
+  region   = "ZH"
+}
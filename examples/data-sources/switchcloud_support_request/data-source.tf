@@ -0,0 +1,11 @@
+data "switchcloud_support_request" "example" {
+  id = switchcloud_support_request.example.id
+
+  # Stop before creating GPU instances until the support request is approved
+  lifecycle {
+    postcondition {
+      condition     = self.status == "approved"
+      error_message = "Support request ${self.reference_number} is ${self.status}, not approved yet."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "switchcloud_webhook_deliveries" "example" {
+  webhook_id = switchcloud_webhook.example.id
+  limit      = 10
+}
+
+output "failed_deliveries" {
+  value = [for d in data.switchcloud_webhook_deliveries.example.deliveries : d.id if d.status == "failed"]
+}
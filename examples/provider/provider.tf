@@ -1,4 +1,9 @@
 provider "switchcloud" {
   endpoint = "https://api.switchcloud.com"
   api_key  = var.switchcloud_api_key
+
+  # Added to every project, labels set on a project take precedence
+  default_labels = {
+    department = "Informatikdienste"
+  }
 }
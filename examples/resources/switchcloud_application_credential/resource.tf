@@ -0,0 +1,27 @@
+resource "switchcloud_project" "example" {
+  name = "my-project"
+}
+
+resource "switchcloud_application_credential" "example" {
+  project_id      = switchcloud_project.example.id
+  region          = "ZH"
+  name            = "ci"
+  description     = "Used by the CI pipeline"
+  expires_in_days = 90
+  roles           = ["member"]
+
+  access_rules = [
+    {
+      service = "compute"
+      method  = "GET"
+      path    = "/v2.1/servers/**"
+    },
+  ]
+}
+
+provider "openstack" {
+  auth_url                      = switchcloud_project.example.openstack_projects["ZH"].auth_url
+  region                        = "ZH"
+  application_credential_id     = switchcloud_application_credential.example.id
+  application_credential_secret = switchcloud_application_credential.example.secret
+}
@@ -0,0 +1 @@
+terraform import switchcloud_budget_alert.example "project-id/budget-id/alert-id"
\ No newline at end of file
@@ -0,0 +1,15 @@
+resource "switchcloud_project_budget" "example" {
+  project_id       = switchcloud_project.example.id
+  amount           = 5000
+  period           = "yearly"
+  alert_thresholds = [50, 80, 100]
+}
+
+resource "switchcloud_budget_alert" "example" {
+  project_id   = switchcloud_project.example.id
+  budget_id    = switchcloud_project_budget.example.id
+  thresholds   = [80, 100]
+  emails       = ["pi@example.com", "finance@example.com"]
+  webhook_url  = "https://chat.example.com/hooks/budget"
+  member_roles = ["owner"]
+}
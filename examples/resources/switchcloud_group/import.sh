@@ -0,0 +1 @@
+terraform import switchcloud_group.example "group-id"
\ No newline at end of file
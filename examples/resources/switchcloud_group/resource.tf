@@ -0,0 +1,11 @@
+resource "switchcloud_group" "example" {
+  name        = "researchers"
+  description = "Members of the research group"
+}
+
+# Grant all members of the group access to a project
+resource "switchcloud_project_member" "example" {
+  project_id = switchcloud_project.example.id
+  group_id   = switchcloud_group.example.id
+  role       = "reader"
+}
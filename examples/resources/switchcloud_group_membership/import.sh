@@ -0,0 +1 @@
+terraform import switchcloud_group_membership.example "group-id/member-id"
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "switchcloud_group_membership" "example" {
+  group_id = switchcloud_group.example.id
+  email    = "researcher@example.com"
+}
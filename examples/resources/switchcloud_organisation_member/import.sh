@@ -0,0 +1 @@
+terraform import switchcloud_organisation_member.example "organisation-id/member-id"
\ No newline at end of file
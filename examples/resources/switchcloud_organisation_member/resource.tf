@@ -0,0 +1,4 @@
+resource "switchcloud_organisation_member" "example" {
+  email = "finance@example.com"
+  role  = "billing"
+}
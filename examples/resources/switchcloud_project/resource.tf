@@ -1,4 +1,25 @@
 resource "switchcloud_project" "example" {
   name        = "my-project"
   description = "An example project created via Terraform"
+
+  principal_investigator = "pi@example.com"
+  technical_contact      = "it-support@example.com"
+  end_date               = "2027-12-31"
+  purpose                = "Genome sequencing pipeline"
+
+  # Plans show a warning once the project is within the provider expiry_warning_days of expiring
+  expires_at = "2027-01-31T00:00:00Z"
+
+  labels = {
+    grant          = "SNF-200021"
+    classification = "confidential"
+  }
+}
+
+# Configure the OpenStack provider for the project in the Zurich region
+provider "openstack" {
+  auth_url    = switchcloud_project.example.openstack_projects["ZH"].auth_url
+  tenant_id   = switchcloud_project.example.openstack_projects["ZH"].project_id
+  domain_name = switchcloud_project.example.openstack_projects["ZH"].domain
+  region      = "ZH"
 }
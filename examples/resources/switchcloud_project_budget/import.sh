@@ -0,0 +1 @@
+terraform import switchcloud_project_budget.example "project-id/budget-id"
\ No newline at end of file
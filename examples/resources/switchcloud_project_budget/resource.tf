@@ -0,0 +1,12 @@
+resource "switchcloud_project" "example" {
+  name = "my-project"
+}
+
+resource "switchcloud_project_budget" "example" {
+  project_id       = switchcloud_project.example.id
+  amount           = 5000
+  currency         = "CHF"
+  period           = "yearly"
+  hard_limit       = false
+  alert_thresholds = [50, 80, 100]
+}
@@ -0,0 +1 @@
+terraform import switchcloud_project_quota.example "project-id/region"
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "switchcloud_project" "example" {
+  name = "my-project"
+}
+
+resource "switchcloud_project_quota" "example" {
+  project_id   = switchcloud_project.example.id
+  region       = "ZH"
+  instances    = 20
+  cores        = 64
+  ram_mb       = 262144
+  floating_ips = 4
+  reason       = "Additional build agents for the CI pipeline"
+}
@@ -0,0 +1,18 @@
+resource "switchcloud_project" "example" {
+  name = "my-project"
+}
+
+resource "time_rotating" "s3" {
+  rotation_days = 90
+}
+
+resource "switchcloud_s3_credential" "example" {
+  project_id = switchcloud_project.example.id
+
+  # Rotate the key pair every 90 days
+  rotation_trigger = time_rotating.s3.id
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
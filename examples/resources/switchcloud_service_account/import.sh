@@ -0,0 +1 @@
+terraform import switchcloud_service_account.example "service-account-id"
\ No newline at end of file
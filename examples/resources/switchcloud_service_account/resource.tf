@@ -0,0 +1,10 @@
+resource "switchcloud_service_account" "example" {
+  name        = "ci"
+  description = "Used by the CI pipeline"
+}
+
+# Grant the service account access to a project
+resource "switchcloud_project_member" "example" {
+  project_id         = switchcloud_project.example.id
+  service_account_id = switchcloud_service_account.example.id
+}
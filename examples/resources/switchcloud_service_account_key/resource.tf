@@ -0,0 +1,15 @@
+resource "switchcloud_service_account" "example" {
+  name = "ci"
+}
+
+resource "switchcloud_service_account_key" "example" {
+  service_account_id = switchcloud_service_account.example.id
+  description        = "GitLab CI"
+  expires_in_days    = 365
+  scopes             = ["projects:read", "projects:write"]
+}
+
+output "ci_api_key" {
+  value     = switchcloud_service_account_key.example.token
+  sensitive = true
+}
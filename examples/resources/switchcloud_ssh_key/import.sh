@@ -0,0 +1 @@
+terraform import switchcloud_ssh_key.example "ssh-key-id"
\ No newline at end of file
@@ -0,0 +1,12 @@
+# Key of the user of the API key, distributed to all of their projects
+resource "switchcloud_ssh_key" "example" {
+  name       = "alice-laptop"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
+
+# Key distributed to all members of a project, e.g. for a deployment pipeline
+resource "switchcloud_ssh_key" "deploy" {
+  name       = "deploy"
+  public_key = var.deploy_public_key
+  project_id = switchcloud_project.example.id
+}
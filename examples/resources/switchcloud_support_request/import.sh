@@ -0,0 +1 @@
+terraform import switchcloud_support_request.example "support-request-id"
\ No newline at end of file
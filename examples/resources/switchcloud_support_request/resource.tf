@@ -0,0 +1,13 @@
+resource "switchcloud_support_request" "example" {
+  project_id = switchcloud_project.example.id
+  category   = "gpu_access"
+  subject    = "Access to GPU flavours"
+  body       = <<-EOT
+    We need GPU flavours in region ZH to train models for the
+    research project, starting next month.
+  EOT
+}
+
+output "support_reference" {
+  value = switchcloud_support_request.example.reference_number
+}
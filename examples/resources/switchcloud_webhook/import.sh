@@ -0,0 +1 @@
+terraform import switchcloud_webhook.example "webhook-id"
\ No newline at end of file
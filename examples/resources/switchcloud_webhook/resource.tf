@@ -0,0 +1,8 @@
+resource "switchcloud_webhook" "example" {
+  url    = "https://hooks.example.org/switchcloud"
+  events = ["project.created", "project.archived", "budget.threshold_reached"]
+
+  # The secret is never stored in the state, increase the version to rotate it
+  signing_secret         = var.webhook_signing_secret
+  signing_secret_version = 1
+}
@@ -0,0 +1,540 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApplicationCredentialResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationCredentialResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationCredentialResource{}
+
+// accessRuleMethods are the HTTP methods an access rule can allow.
+var accessRuleMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"}
+
+// accessRuleAttrTypes are the attribute types of an application credential access rule.
+var accessRuleAttrTypes = map[string]attr.Type{
+	"service": types.StringType,
+	"method":  types.StringType,
+	"path":    types.StringType,
+}
+
+func NewApplicationCredentialResource() resource.Resource {
+	return &ApplicationCredentialResource{}
+}
+
+// ApplicationCredentialResource defines the resource implementation.
+type ApplicationCredentialResource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// ApplicationCredentialResourceModel describes the resource data model.
+type ApplicationCredentialResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	ProjectId     types.String `tfsdk:"project_id"`
+	Region        types.String `tfsdk:"region"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	ExpiresInDays types.Int64  `tfsdk:"expires_in_days"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	Roles         types.Set    `tfsdk:"roles"`
+	AccessRules   types.List   `tfsdk:"access_rules"`
+	Secret        types.String `tfsdk:"secret"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+// ApplicationCredentialAccessRuleModel describes an access rule of an application credential.
+type ApplicationCredentialAccessRuleModel struct {
+	Service types.String `tfsdk:"service"`
+	Method  types.String `tfsdk:"method"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// ApplicationCredential represents the API response structure.
+type ApplicationCredential struct {
+	Id          string                            `json:"id"`
+	ProjectId   string                            `json:"project_id"`
+	Region      string                            `json:"region"`
+	Name        string                            `json:"name"`
+	Description *string                           `json:"description,omitempty"`
+	ExpiresAt   *string                           `json:"expires_at,omitempty"`
+	Roles       []string                          `json:"roles"`
+	AccessRules []ApplicationCredentialAccessRule `json:"access_rules"`
+	Secret      string                            `json:"secret,omitempty"`
+	CreatedAt   string                            `json:"created_at"`
+}
+
+// ApplicationCredentialAccessRule represents an access rule of an application credential.
+type ApplicationCredentialAccessRule struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+	Path    string `json:"path"`
+}
+
+// ApplicationCredentialCreateRequest represents the request body for creating an application credential.
+type ApplicationCredentialCreateRequest struct {
+	Region      string                            `json:"region"`
+	Name        string                            `json:"name"`
+	Description string                            `json:"description,omitempty"`
+	ExpiresAt   string                            `json:"expires_at,omitempty"`
+	Roles       []string                          `json:"roles,omitempty"`
+	AccessRules []ApplicationCredentialAccessRule `json:"access_rules,omitempty"`
+}
+
+func (r *ApplicationCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_credential"
+}
+
+func (r *ApplicationCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "An OpenStack application credential of a project in a region of the Switchcloud platform. " +
+			"The secret is only returned when the credential is created. All changes, and the expiry of the credential, replace the credential with a new one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Application credential identifier, used as `application_credential_id` by the OpenStack provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to which the application credential belongs.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region in which the application credential is created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Application credential name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Application credential description",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in_days": expiresInDaysAttribute("application credential"),
+			"expires_at":      expiresAtAttribute("application credential"),
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "Roles delegated to the application credential. Defaults to all roles of the user in the project.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Restrict the application credential to the listed API calls",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							MarkdownDescription: "OpenStack service type, e.g. `compute` or `object-store`",
+							Required:            true,
+						},
+						"method": schema.StringAttribute{
+							MarkdownDescription: "HTTP method, one of `GET`, `HEAD`, `POST`, `PUT`, `PATCH` or `DELETE`",
+							Required:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "API path, may contain `*` and `**` wildcards, e.g. `/v2.1/servers/**`",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Application credential secret. Only available after the credential was created by Terraform.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the application credential was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationCredentialResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateExpiresInDays(config.ExpiresInDays)...)
+
+	if !config.AccessRules.IsNull() && !config.AccessRules.IsUnknown() {
+		var accessRules []ApplicationCredentialAccessRuleModel
+		resp.Diagnostics.Append(config.AccessRules.ElementsAs(ctx, &accessRules, false)...)
+
+		for _, accessRule := range accessRules {
+			if accessRule.Method.IsUnknown() || accessRule.Method.IsNull() {
+				continue
+			}
+			if !slices.Contains(accessRuleMethods, accessRule.Method.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("access_rules"),
+					"Configuration Error",
+					fmt.Sprintf("'method' must be one of %s. Got: %s", strings.Join(accessRuleMethods, ", "), accessRule.Method.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *ApplicationCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	r.client = client
+	r.endpoint = endpoint
+}
+
+func (r *ApplicationCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanForExpiry(ctx, "application credential", req, resp)
+}
+
+func (r *ApplicationCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	createRequest := ApplicationCredentialCreateRequest{
+		Region:      data.Region.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		ExpiresAt:   expiresAtFromDays(data.ExpiresInDays),
+	}
+
+	if !data.Roles.IsUnknown() && !data.Roles.IsNull() {
+		resp.Diagnostics.Append(data.Roles.ElementsAs(ctx, &createRequest.Roles, false)...)
+	}
+
+	if !data.AccessRules.IsNull() {
+		var accessRules []ApplicationCredentialAccessRuleModel
+		resp.Diagnostics.Append(data.AccessRules.ElementsAs(ctx, &accessRules, false)...)
+
+		for _, accessRule := range accessRules {
+			createRequest.AccessRules = append(createRequest.AccessRules, ApplicationCredentialAccessRule{
+				Service: accessRule.Service.ValueString(),
+				Method:  accessRule.Method.ValueString(),
+				Path:    accessRule.Path.ValueString(),
+			})
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal create request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", r.credentialsUrl(data), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create application credential, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var applicationCredential ApplicationCredential
+	if err := json.Unmarshal(body, &applicationCredential); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, applicationCredential)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created an application credential resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", r.credentialsUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read application credential, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check if application credential was deleted
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var applicationCredential ApplicationCredential
+	if err := json.Unmarshal(body, &applicationCredential); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, applicationCredential)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// All configurable attributes require replacement, so there is nothing to send to the API
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated an application credential resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApplicationCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApplicationCredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", r.credentialsUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete application credential, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status, a credential that is already gone is fine
+	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an application credential resource")
+}
+
+func (r *ApplicationCredentialResource) credentialsUrl(data ApplicationCredentialResourceModel) string {
+	return strings.TrimSuffix(r.endpoint, "/") + "/api/v1/projects/" + data.ProjectId.ValueString() + "/application-credentials"
+}
+
+// update sets the model from the API response. The secret is only part of the
+// response when the credential is created and is kept from the state otherwise.
+func (m *ApplicationCredentialResourceModel) update(ctx context.Context, applicationCredential ApplicationCredential) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(applicationCredential.Id)
+	m.ProjectId = types.StringValue(applicationCredential.ProjectId)
+	m.Region = types.StringValue(applicationCredential.Region)
+	m.Name = types.StringValue(applicationCredential.Name)
+	m.Description = types.StringPointerValue(applicationCredential.Description)
+	m.ExpiresAt = types.StringPointerValue(applicationCredential.ExpiresAt)
+	m.CreatedAt = types.StringValue(applicationCredential.CreatedAt)
+
+	if applicationCredential.Secret != "" {
+		m.Secret = types.StringValue(applicationCredential.Secret)
+	}
+
+	var d diag.Diagnostics
+	m.Roles, d = types.SetValueFrom(ctx, types.StringType, applicationCredential.Roles)
+	diags.Append(d...)
+
+	// Keep unset access rules unset if the API reports them empty
+	if len(applicationCredential.AccessRules) > 0 || !m.AccessRules.IsNull() {
+		accessRules := make([]ApplicationCredentialAccessRuleModel, 0, len(applicationCredential.AccessRules))
+		for _, accessRule := range applicationCredential.AccessRules {
+			accessRules = append(accessRules, ApplicationCredentialAccessRuleModel{
+				Service: types.StringValue(accessRule.Service),
+				Method:  types.StringValue(accessRule.Method),
+				Path:    types.StringValue(accessRule.Path),
+			})
+		}
+		m.AccessRules, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: accessRuleAttrTypes}, accessRules)
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccApplicationCredentialResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationCredentialResourceInvalidMethodConfig,
+				ExpectError: regexp.MustCompile("'method' must be one of"),
+			},
+			{
+				Config: testAccApplicationCredentialResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("secret"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("roles"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("member"),
+							knownvalue.StringExact("reader"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("access_rules"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: testAccApplicationCredentialResourceAccessRulesConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("roles"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("reader"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("access_rules").AtSliceIndex(0).AtMapKey("path"),
+						knownvalue.StringExact("/v2.1/servers/**"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationCredentialResourceExpiry(t *testing.T) {
+	compareId := statecheck.CompareValue(compare.ValuesDiffer())
+	t.Cleanup(func() { now = time.Now })
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationCredentialResourceExpiryConfig(0),
+				ExpectError: regexp.MustCompile("'expires_in_days' must be at least 1"),
+			},
+			{
+				Config: testAccApplicationCredentialResourceExpiryConfig(30),
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareId.AddStateValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("id"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("expires_at"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Once expired the credential is deleted and created again with a new expiry
+			{
+				PreConfig: func() {
+					now = func() time.Time { return time.Now().AddDate(0, 0, 31) }
+				},
+				Config: testAccApplicationCredentialResourceExpiryConfig(30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_application_credential.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareId.AddStateValue(
+						"switchcloud_application_credential.test",
+						tfjsonpath.New("id"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccApplicationCredentialResourceInvalidMethodConfig = `
+resource "switchcloud_application_credential" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  region     = "ZH"
+  name       = "ci"
+
+  access_rules = [
+    {
+      service = "compute"
+      method  = "FETCH"
+      path    = "/v2.1/servers"
+    },
+  ]
+}
+`
+
+const testAccApplicationCredentialResourceConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_application_credential" "test" {
+  project_id  = switchcloud_project.test.id
+  region      = "ZH"
+  name        = "ci"
+  description = "Used by the CI pipeline"
+}
+`
+
+const testAccApplicationCredentialResourceAccessRulesConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_application_credential" "test" {
+  project_id  = switchcloud_project.test.id
+  region      = "ZH"
+  name        = "ci"
+  description = "Used by the CI pipeline"
+  roles       = ["reader"]
+
+  access_rules = [
+    {
+      service = "compute"
+      method  = "GET"
+      path    = "/v2.1/servers/**"
+    },
+  ]
+}
+`
+
+func testAccApplicationCredentialResourceExpiryConfig(expiresInDays int) string {
+	return fmt.Sprintf(`
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_application_credential" "test" {
+  project_id      = switchcloud_project.test.id
+  region          = "ZH"
+  name            = "ci"
+  expires_in_days = %[1]d
+}
+`, expiresInDays)
+}
@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// auditEventsPageSize is the number of audit events requested per page.
+const auditEventsPageSize = 100
+
+// auditEventsMaxEvents is the number of audit events read if `max_events` is not set.
+const auditEventsMaxEvents = 10000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditEventsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AuditEventsDataSource{}
+
+func NewAuditEventsDataSource() datasource.DataSource {
+	return &AuditEventsDataSource{}
+}
+
+// AuditEventsDataSource defines the data source implementation.
+type AuditEventsDataSource struct {
+	client         *http.Client
+	endpoint       string
+	organisationId string
+}
+
+// AuditEventsDataSourceModel describes the data source data model.
+type AuditEventsDataSourceModel struct {
+	OrganisationId types.String      `tfsdk:"organisation_id"`
+	ProjectId      types.String      `tfsdk:"project_id"`
+	StartTime      types.String      `tfsdk:"start_time"`
+	EndTime        types.String      `tfsdk:"end_time"`
+	Actor          types.String      `tfsdk:"actor"`
+	EventType      types.String      `tfsdk:"event_type"`
+	MaxEvents      types.Int64       `tfsdk:"max_events"`
+	Events         []AuditEventModel `tfsdk:"events"`
+}
+
+// AuditEventModel describes an entry of the audit log.
+type AuditEventModel struct {
+	Id             types.String `tfsdk:"id"`
+	EventType      types.String `tfsdk:"event_type"`
+	ActorId        types.String `tfsdk:"actor_id"`
+	ActorEMail     types.String `tfsdk:"actor_email"`
+	OrganisationId types.String `tfsdk:"organisation_id"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	TargetType     types.String `tfsdk:"target_type"`
+	TargetId       types.String `tfsdk:"target_id"`
+	Details        types.Map    `tfsdk:"details"`
+	OccurredAt     types.String `tfsdk:"occurred_at"`
+}
+
+// AuditEvent represents an audit event in the API response.
+type AuditEvent struct {
+	Id             string            `json:"id"`
+	EventType      string            `json:"event_type"`
+	ActorId        string            `json:"actor_id"`
+	ActorEMail     string            `json:"actor_email"`
+	OrganisationId string            `json:"organisation_id"`
+	ProjectId      *string           `json:"project_id"`
+	TargetType     string            `json:"target_type"`
+	TargetId       string            `json:"target_id"`
+	Details        map[string]string `json:"details"`
+	OccurredAt     string            `json:"occurred_at"`
+}
+
+// AuditEventPage represents a page of audit events in the API response.
+type AuditEventPage struct {
+	Events        []AuditEvent `json:"events"`
+	NextPageToken string       `json:"next_page_token"`
+}
+
+func (d *AuditEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_events"
+}
+
+func (d *AuditEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Events of the audit log of an organisation or project in the Switchcloud platform, oldest first. " +
+			"Pages of the audit log matching the filters are read until `max_events` is reached, so narrow down the time range for large organisations.",
+
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				MarkdownDescription: "Organisation ID whose audit log is read. Defaults to the provider `organisation_id` unless `project_id` is set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Only list events of this project",
+				Optional:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Only list events at or after this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "Only list events before this RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"actor": schema.StringAttribute{
+				MarkdownDescription: "Only list events caused by this user or service account, given by ID or e-mail address",
+				Optional:            true,
+			},
+			"event_type": schema.StringAttribute{
+				MarkdownDescription: "Only list events of this type, e.g. `project.member_added`",
+				Optional:            true,
+			},
+			"max_events": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of events to read. Plans show a warning if more events match the filters. Defaults to `%d`.", auditEventsMaxEvents),
+				Optional:            true,
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "Events of the audit log",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Audit event identifier",
+							Computed:            true,
+						},
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the event, e.g. `project.member_added`",
+							Computed:            true,
+						},
+						"actor_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the user or service account that caused the event",
+							Computed:            true,
+						},
+						"actor_email": schema.StringAttribute{
+							MarkdownDescription: "Email of the user that caused the event, empty for service accounts",
+							Computed:            true,
+						},
+						"organisation_id": schema.StringAttribute{
+							MarkdownDescription: "Organisation ID of the event",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "Project ID of the event, if it concerns a project",
+							Computed:            true,
+						},
+						"target_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the changed object, e.g. `project_member`",
+							Computed:            true,
+						},
+						"target_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the changed object",
+							Computed:            true,
+						},
+						"details": schema.MapAttribute{
+							MarkdownDescription: "Additional details of the event, e.g. the e-mail address of an added member",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"occurred_at": schema.StringAttribute{
+							MarkdownDescription: "When the event occurred",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditEventsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config AuditEventsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var startTime, endTime time.Time
+	var err error
+
+	if !config.StartTime.IsNull() && !config.StartTime.IsUnknown() {
+		startTime, err = time.Parse(time.RFC3339, config.StartTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("start_time"),
+				"Configuration Error",
+				fmt.Sprintf("'start_time' must be an RFC 3339 timestamp such as 2025-01-01T00:00:00Z. Got: %s", config.StartTime.ValueString()),
+			)
+		}
+	}
+
+	if !config.EndTime.IsNull() && !config.EndTime.IsUnknown() {
+		endTime, err = time.Parse(time.RFC3339, config.EndTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Configuration Error",
+				fmt.Sprintf("'end_time' must be an RFC 3339 timestamp such as 2025-12-31T23:59:59Z. Got: %s", config.EndTime.ValueString()),
+			)
+		}
+	}
+
+	if !config.MaxEvents.IsNull() && !config.MaxEvents.IsUnknown() && config.MaxEvents.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_events"),
+			"Configuration Error",
+			fmt.Sprintf("'max_events' must be at least 1. Got: %d", config.MaxEvents.ValueInt64()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() || startTime.IsZero() || endTime.IsZero() {
+		return
+	}
+
+	if !endTime.After(startTime) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Configuration Error",
+			"'end_time' must be after 'start_time'.",
+		)
+	}
+}
+
+func (d *AuditEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	// The default organisation is optional
+	organisationId, _ := providerData["organisation_id"].(string)
+
+	d.client = client
+	d.endpoint = endpoint
+	d.organisationId = organisationId
+}
+
+func (d *AuditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditEventsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The data source organisation overrides the provider default, a project is enough on its own
+	if data.OrganisationId.IsNull() && data.ProjectId.IsNull() {
+		if d.organisationId == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organisation_id"),
+				"Missing Organisation",
+				"'organisation_id' or 'project_id' must be set on the data source, or 'organisation_id' on the provider.",
+			)
+			return
+		}
+		data.OrganisationId = types.StringValue(d.organisationId)
+	}
+
+	maxEvents := int64(auditEventsMaxEvents)
+	if !data.MaxEvents.IsNull() {
+		maxEvents = data.MaxEvents.ValueInt64()
+	}
+
+	query := url.Values{}
+	for name, value := range map[string]types.String{
+		"organisation_id": data.OrganisationId,
+		"project_id":      data.ProjectId,
+		"start_time":      data.StartTime,
+		"end_time":        data.EndTime,
+		"actor":           data.Actor,
+		"event_type":      data.EventType,
+	} {
+		if !value.IsNull() {
+			query.Set(name, value.ValueString())
+		}
+	}
+
+	auditEvents, truncated, diags := d.readEvents(ctx, query, maxEvents)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if truncated {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("max_events"),
+			"Audit Events Truncated",
+			fmt.Sprintf("More than %d audit events match the filters, only the oldest %d are read. Narrow down the time range or raise 'max_events'.", maxEvents, maxEvents),
+		)
+	}
+
+	data.Events = []AuditEventModel{}
+	for _, auditEvent := range auditEvents {
+		details, diags := types.MapValueFrom(ctx, types.StringType, auditEvent.Details)
+		resp.Diagnostics.Append(diags...)
+
+		data.Events = append(data.Events, AuditEventModel{
+			Id:             types.StringValue(auditEvent.Id),
+			EventType:      types.StringValue(auditEvent.EventType),
+			ActorId:        types.StringValue(auditEvent.ActorId),
+			ActorEMail:     types.StringValue(auditEvent.ActorEMail),
+			OrganisationId: types.StringValue(auditEvent.OrganisationId),
+			ProjectId:      types.StringPointerValue(auditEvent.ProjectId),
+			TargetType:     types.StringValue(auditEvent.TargetType),
+			TargetId:       types.StringValue(auditEvent.TargetId),
+			Details:        details,
+			OccurredAt:     types.StringValue(auditEvent.OccurredAt),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an audit events data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readEvents follows the pages of the audit log until all events matching the query
+// or maxEvents events are read. It reports whether further events were left out.
+func (d *AuditEventsDataSource) readEvents(ctx context.Context, query url.Values, maxEvents int64) ([]AuditEvent, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	auditEvents := []AuditEvent{}
+	pageTokens := map[string]bool{}
+
+	query.Set("page_size", fmt.Sprint(min(auditEventsPageSize, maxEvents)))
+	for page := 1; ; page++ {
+		auditEventPage, pageDiags := d.readPage(ctx, query)
+		diags.Append(pageDiags...)
+
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		tflog.Debug(ctx, "read a page of audit events", map[string]interface{}{
+			"page":   page,
+			"events": len(auditEventPage.Events),
+		})
+
+		for _, auditEvent := range auditEventPage.Events {
+			if int64(len(auditEvents)) == maxEvents {
+				return auditEvents, true, diags
+			}
+			auditEvents = append(auditEvents, auditEvent)
+		}
+
+		if auditEventPage.NextPageToken == "" {
+			return auditEvents, false, diags
+		}
+
+		if int64(len(auditEvents)) == maxEvents {
+			return auditEvents, true, diags
+		}
+
+		// Guard against an API that keeps returning the same pages
+		if pageTokens[auditEventPage.NextPageToken] {
+			diags.AddError("Client Error", fmt.Sprintf("API returned the page token %q of the audit log more than once", auditEventPage.NextPageToken))
+			return nil, false, diags
+		}
+		pageTokens[auditEventPage.NextPageToken] = true
+
+		query.Set("page_token", auditEventPage.NextPageToken)
+	}
+}
+
+// readPage reads a single page of the audit log.
+func (d *AuditEventsDataSource) readPage(ctx context.Context, query url.Values) (AuditEventPage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var auditEventPage AuditEventPage
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(d.endpoint, "/")+"/api/v1/audit-events?"+query.Encode(), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return auditEventPage, diags
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read audit events, got error: %s", err)))
+		return auditEventPage, diags
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return auditEventPage, diags
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return auditEventPage, diags
+	}
+
+	// Parse response
+	if err := json.Unmarshal(body, &auditEventPage); err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return auditEventPage, diags
+	}
+
+	return auditEventPage, diags
+}
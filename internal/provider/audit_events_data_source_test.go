@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAuditEventsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuditEventsDataSourceInvalidTimeRangeConfig,
+				ExpectError: regexp.MustCompile("'end_time' must be after 'start_time'"),
+			},
+			// All pages are read
+			{
+				Config: testAccAuditEventsDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events"),
+						knownvalue.ListSizeExact(250),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events").AtSliceIndex(249).AtMapKey("occurred_at"),
+						knownvalue.StringExact("2025-01-11T09:00:00Z"),
+					),
+				},
+			},
+			// Reading stops at max_events with a warning
+			{
+				Config: testAccAuditEventsDataSourceMaxEventsConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events"),
+						knownvalue.ListSizeExact(120),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events").AtSliceIndex(0).AtMapKey("occurred_at"),
+						knownvalue.StringExact("2025-01-01T00:00:00Z"),
+					),
+				},
+			},
+			{
+				Config: testAccAuditEventsDataSourceFilterConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events"),
+						knownvalue.ListSizeExact(12),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events").AtSliceIndex(0).AtMapKey("event_type"),
+						knownvalue.StringExact("project.member_removed"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events").AtSliceIndex(0).AtMapKey("actor_email"),
+						knownvalue.StringExact("bob@example.org"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events").AtSliceIndex(0).AtMapKey("target_type"),
+						knownvalue.StringExact("project_member"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_audit_events.test",
+						tfjsonpath.New("events").AtSliceIndex(0).AtMapKey("details").AtMapKey("email"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccAuditEventsDataSourceInvalidTimeRangeConfig = `
+data "switchcloud_audit_events" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  start_time = "2025-01-02T00:00:00Z"
+  end_time   = "2025-01-01T00:00:00Z"
+}
+`
+
+const testAccAuditEventsDataSourceConfig = `
+data "switchcloud_audit_events" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+}
+`
+
+const testAccAuditEventsDataSourceMaxEventsConfig = `
+data "switchcloud_audit_events" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  max_events = 120
+}
+`
+
+const testAccAuditEventsDataSourceFilterConfig = `
+data "switchcloud_audit_events" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  start_time = "2025-01-01T00:00:00Z"
+  end_time   = "2025-01-02T00:00:00Z"
+  actor      = "bob@example.org"
+  event_type = "project.member_removed"
+}
+`
+
+func TestAuditEventsDataSourceReadEvents(t *testing.T) {
+	t.Run("repeated page token", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"events":[{"id":"event-1"}],"next_page_token":"2"}`))
+		}))
+		defer server.Close()
+
+		d := &AuditEventsDataSource{client: server.Client(), endpoint: server.URL}
+
+		_, _, diags := d.readEvents(context.Background(), url.Values{}, auditEventsMaxEvents)
+		if !diags.HasError() {
+			t.Fatal("expected an error for a repeated page token")
+		}
+		if detail := diags[0].Detail(); !strings.Contains(detail, "more than once") {
+			t.Fatalf("unexpected error: %s", detail)
+		}
+	})
+
+	t.Run("max events", func(t *testing.T) {
+		var pageSizes []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pageSizes = append(pageSizes, r.URL.Query().Get("page_size"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"events":[{"id":"event-1"},{"id":"event-2"}],"next_page_token":"` + fmt.Sprint(len(pageSizes)) + `"}`))
+		}))
+		defer server.Close()
+
+		d := &AuditEventsDataSource{client: server.Client(), endpoint: server.URL}
+
+		auditEvents, truncated, diags := d.readEvents(context.Background(), url.Values{}, 3)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if len(auditEvents) != 3 || !truncated {
+			t.Fatalf("expected 3 truncated events, got %d, truncated: %t", len(auditEvents), truncated)
+		}
+		if len(pageSizes) != 2 || pageSizes[0] != "3" {
+			t.Fatalf("expected 2 pages of size 3, got: %v", pageSizes)
+		}
+	})
+}
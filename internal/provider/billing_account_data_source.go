@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BillingAccountDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BillingAccountDataSource{}
+
+func NewBillingAccountDataSource() datasource.DataSource {
+	return &BillingAccountDataSource{}
+}
+
+// BillingAccountDataSource defines the data source implementation.
+type BillingAccountDataSource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// BillingAccountDataSourceModel describes the data source data model.
+type BillingAccountDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	CostCentre     types.String `tfsdk:"cost_centre"`
+	Name           types.String `tfsdk:"name"`
+	OrganisationId types.String `tfsdk:"organisation_id"`
+	Active         types.Bool   `tfsdk:"active"`
+}
+
+// BillingAccount represents the API response structure.
+type BillingAccount struct {
+	Id             string `json:"id"`
+	CostCentre     string `json:"cost_centre"`
+	Name           string `json:"name"`
+	OrganisationId string `json:"organisation_id"`
+	Active         bool   `json:"active"`
+}
+
+func (d *BillingAccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_billing_account"
+}
+
+func (d *BillingAccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Billing account (cost centre) data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Billing account identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cost_centre": schema.StringAttribute{
+				MarkdownDescription: "Cost centre (Kostenstelle) code of the billing account",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Billing account name",
+				Computed:            true,
+			},
+			"organisation_id": schema.StringAttribute{
+				MarkdownDescription: "Organisation ID that owns this billing account",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether projects can be billed to the billing account",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BillingAccountDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config BillingAccountDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that either id or cost_centre is provided
+	if config.Id.IsNull() && config.CostCentre.IsNull() {
+		resp.Diagnostics.AddError(
+			"Configuration Error",
+			"Either 'id' or 'cost_centre' must be provided for a billing account.",
+		)
+	}
+
+	if !config.Id.IsNull() && !config.CostCentre.IsNull() {
+		resp.Diagnostics.AddError(
+			"Configuration Error",
+			"Only one of 'id' or 'cost_centre' can be provided for a billing account.",
+		)
+	}
+}
+
+func (d *BillingAccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	d.client = client
+	d.endpoint = endpoint
+}
+
+func (d *BillingAccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BillingAccountDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Billing accounts are looked up directly by ID, or by filtering on the cost centre
+	requestUrl := strings.TrimSuffix(d.endpoint, "/") + "/api/v1/billing-accounts/" + data.Id.ValueString()
+	if data.Id.IsNull() {
+		query := url.Values{}
+		query.Set("cost_centre", data.CostCentre.ValueString())
+		requestUrl = strings.TrimSuffix(d.endpoint, "/") + "/api/v1/billing-accounts?" + query.Encode()
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", requestUrl, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read billing account, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var billingAccount BillingAccount
+	if data.Id.IsNull() {
+		var billingAccounts []BillingAccount
+		if err := json.Unmarshal(body, &billingAccounts); err != nil {
+			resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+			return
+		}
+
+		if len(billingAccounts) != 1 {
+			resp.Diagnostics.AddError(
+				"Billing Account Not Found",
+				fmt.Sprintf("Expected exactly one billing account with cost centre %s, got: %d", data.CostCentre.ValueString(), len(billingAccounts)),
+			)
+			return
+		}
+		billingAccount = billingAccounts[0]
+	} else if err := json.Unmarshal(body, &billingAccount); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.Id = types.StringValue(billingAccount.Id)
+	data.CostCentre = types.StringValue(billingAccount.CostCentre)
+	data.Name = types.StringValue(billingAccount.Name)
+	data.OrganisationId = types.StringValue(billingAccount.OrganisationId)
+	data.Active = types.BoolValue(billingAccount.Active)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a billing account data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
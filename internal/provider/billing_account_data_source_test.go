@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBillingAccountDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBillingAccountDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_billing_account.by_cost_centre",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("9d0c6f1e-8a43-4d38-9f5e-2b7c1e0a4f11"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_billing_account.by_id",
+						tfjsonpath.New("cost_centre"),
+						knownvalue.StringExact("8765-4321"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_billing_account.by_id",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Institute of Geography"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccBillingAccountDataSourceConfig = `
+data "switchcloud_billing_account" "by_cost_centre" {
+  cost_centre = "1234-5678"
+}
+
+data "switchcloud_billing_account" "by_id" {
+  id = "3f6e2a7b-1c94-4b0d-8e2f-7a5d9c3b1e22"
+}
+`
@@ -0,0 +1,591 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BudgetAlertResource{}
+var _ resource.ResourceWithImportState = &BudgetAlertResource{}
+var _ resource.ResourceWithValidateConfig = &BudgetAlertResource{}
+
+func NewBudgetAlertResource() resource.Resource {
+	return &BudgetAlertResource{}
+}
+
+// BudgetAlertResource defines the resource implementation.
+type BudgetAlertResource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// BudgetAlertResourceModel describes the resource data model.
+type BudgetAlertResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ProjectId   types.String `tfsdk:"project_id"`
+	BudgetId    types.String `tfsdk:"budget_id"`
+	Thresholds  types.List   `tfsdk:"thresholds"`
+	Emails      types.Set    `tfsdk:"emails"`
+	WebhookUrl  types.String `tfsdk:"webhook_url"`
+	MemberRoles types.Set    `tfsdk:"member_roles"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// BudgetAlert represents the API response structure.
+type BudgetAlert struct {
+	Id          string   `json:"id"`
+	ProjectId   string   `json:"project_id"`
+	BudgetId    string   `json:"budget_id"`
+	Thresholds  []int64  `json:"thresholds"`
+	Emails      []string `json:"emails"`
+	WebhookUrl  *string  `json:"webhook_url,omitempty"`
+	MemberRoles []string `json:"member_roles"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// BudgetAlertRequest represents the request body for creating or updating a budget alert.
+type BudgetAlertRequest struct {
+	Thresholds  []int64  `json:"thresholds"`
+	Emails      []string `json:"emails"`
+	WebhookUrl  string   `json:"webhook_url,omitempty"`
+	MemberRoles []string `json:"member_roles"`
+}
+
+func (r *BudgetAlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_budget_alert"
+}
+
+func (r *BudgetAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Notification recipients of a project budget in the Switchcloud platform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Budget alert identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID of the budget.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"budget_id": schema.StringAttribute{
+				MarkdownDescription: "Budget ID whose threshold crossings are notified.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"thresholds": schema.ListAttribute{
+				MarkdownDescription: "Alert thresholds of the budget, in percent, that trigger a notification. All thresholds of the budget are notified if not set.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"emails": schema.SetAttribute{
+				MarkdownDescription: "E-mail addresses that are notified",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"webhook_url": schema.StringAttribute{
+				MarkdownDescription: "URL that receives a POST request for every notification",
+				Optional:            true,
+			},
+			"member_roles": schema.SetAttribute{
+				MarkdownDescription: "Project member roles whose members are notified, any of the `role` values of `switchcloud_project_member`: `" + strings.Join(projectMemberRoles, "`, `") + "`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the budget alert was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the budget alert was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BudgetAlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BudgetAlertResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that at least one notification channel is provided
+	if config.Emails.IsNull() && config.WebhookUrl.IsNull() && config.MemberRoles.IsNull() {
+		resp.Diagnostics.AddError(
+			"Configuration Error",
+			"At least one of 'emails', 'webhook_url' or 'member_roles' must be provided for a budget alert.",
+		)
+	}
+
+	if !config.Emails.IsNull() && !config.Emails.IsUnknown() {
+		var emails []types.String
+		resp.Diagnostics.Append(config.Emails.ElementsAs(ctx, &emails, false)...)
+
+		for _, email := range emails {
+			if email.IsUnknown() || email.IsNull() {
+				continue
+			}
+			if !emailPattern.MatchString(email.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("emails"),
+					"Configuration Error",
+					fmt.Sprintf("'emails' must contain valid e-mail addresses. Got: %s", email.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !config.WebhookUrl.IsNull() && !config.WebhookUrl.IsUnknown() {
+		webhookUrl, err := url.Parse(config.WebhookUrl.ValueString())
+		if err != nil || (webhookUrl.Scheme != "https" && webhookUrl.Scheme != "http") || webhookUrl.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("webhook_url"),
+				"Configuration Error",
+				fmt.Sprintf("'webhook_url' must be an absolute http(s) URL. Got: %s", config.WebhookUrl.ValueString()),
+			)
+		}
+	}
+
+	if !config.MemberRoles.IsNull() && !config.MemberRoles.IsUnknown() {
+		var memberRoles []types.String
+		resp.Diagnostics.Append(config.MemberRoles.ElementsAs(ctx, &memberRoles, false)...)
+
+		for _, memberRole := range memberRoles {
+			if memberRole.IsUnknown() || memberRole.IsNull() {
+				continue
+			}
+			if !slices.Contains(projectMemberRoles, memberRole.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("member_roles"),
+					"Configuration Error",
+					fmt.Sprintf("'member_roles' must only contain %s. Got: %s", strings.Join(projectMemberRoles, ", "), memberRole.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !config.Thresholds.IsNull() && !config.Thresholds.IsUnknown() {
+		var thresholds []types.Int64
+		resp.Diagnostics.Append(config.Thresholds.ElementsAs(ctx, &thresholds, false)...)
+
+		for _, threshold := range thresholds {
+			if threshold.IsUnknown() || threshold.IsNull() {
+				continue
+			}
+			if threshold.ValueInt64() < 1 || threshold.ValueInt64() > 100 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("thresholds"),
+					"Configuration Error",
+					fmt.Sprintf("'thresholds' must be percentages between 1 and 100. Got: %d", threshold.ValueInt64()),
+				)
+			}
+		}
+	}
+}
+
+func (r *BudgetAlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	r.client = client
+	r.endpoint = endpoint
+}
+
+func (r *BudgetAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BudgetAlertResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	createRequest, diags := data.request(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal create request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", r.alertsUrl(data), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create budget alert, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var budgetAlert BudgetAlert
+	if err := json.Unmarshal(body, &budgetAlert); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, budgetAlert)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a budget alert resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BudgetAlertResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", r.alertsUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read budget alert, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check if budget alert was deleted
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var budgetAlert BudgetAlert
+	if err := json.Unmarshal(body, &budgetAlert); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, budgetAlert)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BudgetAlertResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	updateRequest, diags := data.request(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal update request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", r.alertsUrl(data)+"/"+data.Id.ValueString(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to update budget alert, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var budgetAlert BudgetAlert
+	if err := json.Unmarshal(body, &budgetAlert); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, budgetAlert)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a budget alert resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BudgetAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BudgetAlertResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", r.alertsUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete budget alert, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status, an alert that is already gone is fine
+	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a budget alert resource")
+}
+
+func (r *BudgetAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is expected to be in the format: project_id/budget_id/alert_id
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: project_id/budget_id/alert_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("budget_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+// alertsUrl returns the URL of the alerts collection of the budget.
+func (r *BudgetAlertResource) alertsUrl(data BudgetAlertResourceModel) string {
+	return strings.TrimSuffix(r.endpoint, "/") + "/api/v1/projects/" + data.ProjectId.ValueString() + "/budgets/" + data.BudgetId.ValueString() + "/alerts"
+}
+
+// request returns the API request body for the model.
+func (m *BudgetAlertResourceModel) request(ctx context.Context) (BudgetAlertRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := BudgetAlertRequest{
+		Thresholds:  []int64{},
+		Emails:      []string{},
+		WebhookUrl:  m.WebhookUrl.ValueString(),
+		MemberRoles: []string{},
+	}
+
+	if !m.Thresholds.IsNull() {
+		diags.Append(m.Thresholds.ElementsAs(ctx, &request.Thresholds, false)...)
+	}
+	if !m.Emails.IsNull() {
+		diags.Append(m.Emails.ElementsAs(ctx, &request.Emails, false)...)
+	}
+	if !m.MemberRoles.IsNull() {
+		diags.Append(m.MemberRoles.ElementsAs(ctx, &request.MemberRoles, false)...)
+	}
+
+	return request, diags
+}
+
+// update sets the model from the API response.
+func (m *BudgetAlertResourceModel) update(ctx context.Context, budgetAlert BudgetAlert) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(budgetAlert.Id)
+	m.ProjectId = types.StringValue(budgetAlert.ProjectId)
+	m.BudgetId = types.StringValue(budgetAlert.BudgetId)
+	m.WebhookUrl = types.StringPointerValue(budgetAlert.WebhookUrl)
+	m.CreatedAt = types.StringValue(budgetAlert.CreatedAt)
+	m.UpdatedAt = types.StringValue(budgetAlert.UpdatedAt)
+
+	// Keep unset collections unset if the API reports them empty
+	var d diag.Diagnostics
+	if len(budgetAlert.Thresholds) > 0 || !m.Thresholds.IsNull() {
+		m.Thresholds, d = types.ListValueFrom(ctx, types.Int64Type, budgetAlert.Thresholds)
+		diags.Append(d...)
+	}
+	if len(budgetAlert.Emails) > 0 || !m.Emails.IsNull() {
+		m.Emails, d = types.SetValueFrom(ctx, types.StringType, budgetAlert.Emails)
+		diags.Append(d...)
+	}
+	if len(budgetAlert.MemberRoles) > 0 || !m.MemberRoles.IsNull() {
+		m.MemberRoles, d = types.SetValueFrom(ctx, types.StringType, budgetAlert.MemberRoles)
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBudgetAlertResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetAlertResourceNoChannelConfig,
+				ExpectError: regexp.MustCompile("At least one of 'emails', 'webhook_url' or 'member_roles'"),
+			},
+			{
+				Config:      testAccBudgetAlertResourceInvalidMemberRoleConfig,
+				ExpectError: regexp.MustCompile("'member_roles' must only contain owner, member, reader"),
+			},
+			{
+				Config: testAccBudgetAlertResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_budget_alert.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_budget_alert.test",
+						tfjsonpath.New("emails"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("pi@example.com"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_budget_alert.test",
+						tfjsonpath.New("webhook_url"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_budget_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["switchcloud_budget_alert.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["project_id"] + "/" + rs.Primary.Attributes["budget_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testAccBudgetAlertResourceUpdateConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_budget_alert.test",
+						tfjsonpath.New("thresholds"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.Int64Exact(100),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_budget_alert.test",
+						tfjsonpath.New("webhook_url"),
+						knownvalue.StringExact("https://chat.example.com/hooks/budget"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_budget_alert.test",
+						tfjsonpath.New("member_roles"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("owner"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccBudgetAlertResourceNoChannelConfig = `
+resource "switchcloud_budget_alert" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  budget_id  = "budget-12345"
+}
+`
+
+const testAccBudgetAlertResourceInvalidMemberRoleConfig = `
+resource "switchcloud_budget_alert" "test" {
+  project_id   = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  budget_id    = "budget-12345"
+  member_roles = ["owners"]
+}
+`
+
+const testAccBudgetAlertResourceConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_project_budget" "test" {
+  project_id       = switchcloud_project.test.id
+  amount           = 5000
+  period           = "monthly"
+  alert_thresholds = [80, 100]
+}
+
+resource "switchcloud_budget_alert" "test" {
+  project_id = switchcloud_project.test.id
+  budget_id  = switchcloud_project_budget.test.id
+  emails     = ["pi@example.com"]
+}
+`
+
+const testAccBudgetAlertResourceUpdateConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_project_budget" "test" {
+  project_id       = switchcloud_project.test.id
+  amount           = 5000
+  period           = "monthly"
+  alert_thresholds = [80, 100]
+}
+
+resource "switchcloud_budget_alert" "test" {
+  project_id   = switchcloud_project.test.id
+  budget_id    = switchcloud_project_budget.test.id
+  thresholds   = [100]
+  emails       = ["pi@example.com"]
+  webhook_url  = "https://chat.example.com/hooks/budget"
+  member_roles = ["owner"]
+}
+`
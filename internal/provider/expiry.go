@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// now returns the current time. Tests replace it to let credentials expire without waiting.
+var now = time.Now
+
+// expiresInDaysAttribute returns the schema of the `expires_in_days` attribute of
+// credentials that are replaced once they expire, e.g. "application credential".
+func expiresInDaysAttribute(credential string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("Number of days after creation when the %[1]s expires. "+
+			"Once expired, the next apply deletes the %[1]s and creates a new one that is valid for the same number of days. Never expires if not set.", credential),
+		Optional: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// expiresAtAttribute returns the schema of the computed `expires_at` attribute
+// that goes along with `expires_in_days`.
+func expiresAtAttribute(credential string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("When the %s expires, in RFC 3339 format", credential),
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// validateExpiresInDays checks that a configured `expires_in_days` is positive.
+func validateExpiresInDays(expiresInDays types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !expiresInDays.IsNull() && !expiresInDays.IsUnknown() && expiresInDays.ValueInt64() < 1 {
+		diags.AddAttributeError(
+			path.Root("expires_in_days"),
+			"Configuration Error",
+			fmt.Sprintf("'expires_in_days' must be at least 1. Got: %d", expiresInDays.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// expiresAtFromDays returns the expiry timestamp sent to the API when a credential is
+// created, or an empty string for credentials that never expire.
+func expiresAtFromDays(expiresInDays types.Int64) string {
+	if expiresInDays.IsNull() || expiresInDays.IsUnknown() {
+		return ""
+	}
+
+	return now().UTC().AddDate(0, 0, int(expiresInDays.ValueInt64())).Format(time.RFC3339)
+}
+
+// modifyPlanForExpiry replaces an expired credential, so that it is deleted and
+// created again with a new expiry.
+func modifyPlanForExpiry(ctx context.Context, credential string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing expires while the credential is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var priorExpiresAt types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &priorExpiresAt)...)
+
+	if resp.Diagnostics.HasError() || priorExpiresAt.IsNull() || priorExpiresAt.IsUnknown() {
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, priorExpiresAt.ValueString())
+	if err != nil || now().Before(expiry) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("expires_at"),
+		"Credential Expired",
+		fmt.Sprintf("The %s expired at %s and is replaced by a new one.", credential, priorExpiresAt.ValueString()),
+	)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "regexp"
+
+// emailPattern is a loose check for e-mail addresses, the API performs the full validation.
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// isoDateLayout is the layout of ISO 8601 dates without time.
+const isoDateLayout = "2006-01-02"
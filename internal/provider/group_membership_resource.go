@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithValidateConfig = &GroupMembershipResource{}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+// GroupMembershipResource defines the resource implementation.
+type GroupMembershipResource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// GroupMembershipResourceModel describes the resource data model.
+type GroupMembershipResourceModel struct {
+	MemberUserModel
+	Id      types.String `tfsdk:"id"`
+	GroupId types.String `tfsdk:"group_id"`
+}
+
+// GroupMember represents the API response structure.
+type GroupMember struct {
+	Id      string     `json:"id"`
+	GroupId string     `json:"group_id"`
+	UserId  string     `json:"user_id"`
+	User    MemberUser `json:"user"`
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := memberUserAttributes("group member")
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Group member identifier",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"group_id": schema.StringAttribute{
+			MarkdownDescription: "Group ID to which the member should be added.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	})
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A member of a group in the Switchcloud platform.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *GroupMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GroupMembershipResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that either user_id or email is provided
+	resp.Diagnostics.Append(config.validate("a group member")...)
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	r.client = client
+	r.endpoint = endpoint
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	createRequest := data.userCreateRequest()
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal create request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", r.membersUrl(data), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create group member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var groupMember GroupMember
+	if err := json.Unmarshal(body, &groupMember); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.update(groupMember)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a group membership resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", r.membersUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read group member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check if group member was deleted
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var groupMember GroupMember
+	if err := json.Unmarshal(body, &groupMember); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.update(groupMember)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupMembershipResourceModel
+
+	// All configurable attributes require replacement, so there is nothing to send to the API
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a group membership resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", r.membersUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete group member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status, a member that is already gone is fine
+	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a group membership resource")
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is expected to be in the format: group_id/member_id
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: group_id/member_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *GroupMembershipResource) membersUrl(data GroupMembershipResourceModel) string {
+	return strings.TrimSuffix(r.endpoint, "/") + "/api/v1/groups/" + data.GroupId.ValueString() + "/members"
+}
+
+// update sets the model from the API response.
+func (m *GroupMembershipResourceModel) update(groupMember GroupMember) {
+	m.Id = types.StringValue(groupMember.Id)
+	m.GroupId = types.StringValue(groupMember.GroupId)
+	m.MemberUserModel.update(groupMember.UserId, groupMember.User)
+}
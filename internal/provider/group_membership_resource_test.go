@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupMembershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupMembershipResourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("Only one of 'user_id' or 'email'"),
+			},
+			{
+				Config: testAccGroupMembershipResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_group_membership.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group_membership.test",
+						tfjsonpath.New("user_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group_membership.test",
+						tfjsonpath.New("email"),
+						knownvalue.StringExact("user@example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group_membership.test",
+						tfjsonpath.New("display_name"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_group_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["switchcloud_group_membership.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["group_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+		},
+	})
+}
+
+const testAccGroupMembershipResourceAmbiguousConfig = `
+resource "switchcloud_group_membership" "test" {
+  group_id = "3e8b1d6a-9c4f-4a27-b0e5-7f2d6c9a1b84"
+  user_id  = "user-12345"
+  email    = "user@example.com"
+}
+`
+
+const testAccGroupMembershipResourceConfig = `
+resource "switchcloud_group" "test" {
+  name = "researchers"
+}
+
+resource "switchcloud_group_membership" "test" {
+  group_id = switchcloud_group.test.id
+  email    = "user@example.com"
+}
+`
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_group.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group.test",
+						tfjsonpath.New("organisation_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group.test",
+						tfjsonpath.New("description"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group.test",
+						tfjsonpath.New("member_count"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupResourceUpdateConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_group.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("research-staff"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_group.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("Staff of the research group"),
+					),
+				},
+			},
+			// Members added to the group are counted once the group is read again
+			{
+				Config: testAccGroupResourceMemberConfig,
+			},
+			{
+				RefreshState: true,
+				Check:        resource.TestCheckResourceAttr("switchcloud_group.test", "member_count", "1"),
+			},
+		},
+	})
+}
+
+const testAccGroupResourceConfig = `
+resource "switchcloud_group" "test" {
+  name = "researchers"
+}
+`
+
+const testAccGroupResourceUpdateConfig = `
+resource "switchcloud_group" "test" {
+  name        = "research-staff"
+  description = "Staff of the research group"
+}
+`
+
+const testAccGroupResourceMemberConfig = `
+resource "switchcloud_group" "test" {
+  name        = "research-staff"
+  description = "Staff of the research group"
+}
+
+resource "switchcloud_group_membership" "test" {
+  group_id = switchcloud_group.test.id
+  email    = "user@example.com"
+}
+`
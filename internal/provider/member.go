@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MemberUserModel describes the user of a project, organisation or group member,
+// who is either given by user ID or added by e-mail address.
+type MemberUserModel struct {
+	UserId      types.String `tfsdk:"user_id"`
+	EMail       types.String `tfsdk:"email"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+// MemberUser represents the user of a member in the API response.
+type MemberUser struct {
+	Id          string `json:"id"`
+	EMail       string `json:"email"`
+	DisplayName string `json:"display_name"`
+}
+
+// MemberUserCreateRequest identifies the user in the request body for adding a member.
+type MemberUserCreateRequest struct {
+	UserId string `json:"user_id,omitempty"`
+	EMail  string `json:"email,omitempty"`
+}
+
+// memberAlternative is an attribute that identifies a member instead of a user, e.g. a service account.
+type memberAlternative struct {
+	name  string
+	value types.String
+}
+
+// memberUserAttributes returns the schema attributes of the user of a member, e.g. "project member".
+func memberUserAttributes(member string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user_id": schema.StringAttribute{
+			MarkdownDescription: "User ID of the " + member,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the " + member,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the " + member,
+			Computed:            true,
+		},
+	}
+}
+
+// validate checks that the member, e.g. "a project member", is identified by
+// exactly one of `user_id`, `email` and the given alternatives.
+func (m MemberUserModel) validate(member string, alternatives ...memberAlternative) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := []string{"'user_id'", "'email'"}
+	values := []types.String{m.UserId, m.EMail}
+	for _, alternative := range alternatives {
+		names = append(names, "'"+alternative.name+"'")
+		values = append(values, alternative.value)
+	}
+
+	provided := 0
+	for _, value := range values {
+		if !value.IsNull() {
+			provided++
+		}
+	}
+
+	attributes := strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+
+	if provided == 0 {
+		diags.AddError(
+			"Configuration Error",
+			fmt.Sprintf("Either %s must be provided for %s.", attributes, member),
+		)
+	}
+
+	if provided > 1 {
+		diags.AddError(
+			"Configuration Error",
+			fmt.Sprintf("Only one of %s can be provided for %s.", attributes, member),
+		)
+	}
+
+	return diags
+}
+
+// userCreateRequest identifies the user when the member is added. The user ID is
+// only known during plan if it was configured, otherwise the e-mail address is used.
+func (m MemberUserModel) userCreateRequest() MemberUserCreateRequest {
+	if m.UserId.IsUnknown() {
+		return MemberUserCreateRequest{EMail: m.EMail.ValueString()}
+	}
+
+	return MemberUserCreateRequest{UserId: m.UserId.ValueString()}
+}
+
+// update sets the user of the member from the API response.
+func (m *MemberUserModel) update(userId string, user MemberUser) {
+	m.UserId = types.StringValue(userId)
+	m.EMail = types.StringValue(user.EMail)
+	m.DisplayName = types.StringValue(user.DisplayName)
+}
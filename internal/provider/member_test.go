@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMemberUserModelValidate(t *testing.T) {
+	t.Run("user id", func(t *testing.T) {
+		m := MemberUserModel{UserId: types.StringValue("user-1"), EMail: types.StringNull()}
+
+		if diags := m.validate("a group member"); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		m := MemberUserModel{UserId: types.StringNull(), EMail: types.StringNull()}
+
+		diags := m.validate("a project member", memberAlternative{name: "group_id", value: types.StringNull()})
+		if !diags.HasError() {
+			t.Fatal("expected an error without user_id, email or group_id")
+		}
+		if detail := diags[0].Detail(); detail != "Either 'user_id', 'email' or 'group_id' must be provided for a project member." {
+			t.Fatalf("unexpected error: %s", detail)
+		}
+	})
+
+	t.Run("both", func(t *testing.T) {
+		m := MemberUserModel{UserId: types.StringValue("user-1"), EMail: types.StringValue("alice@example.org")}
+
+		diags := m.validate("an organisation member")
+		if !diags.HasError() {
+			t.Fatal("expected an error with both user_id and email")
+		}
+		if detail := diags[0].Detail(); detail != "Only one of 'user_id' or 'email' can be provided for an organisation member." {
+			t.Fatalf("unexpected error: %s", detail)
+		}
+	})
+}
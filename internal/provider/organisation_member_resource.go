@@ -0,0 +1,453 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganisationMemberResource{}
+var _ resource.ResourceWithImportState = &OrganisationMemberResource{}
+var _ resource.ResourceWithValidateConfig = &OrganisationMemberResource{}
+
+// organisationMemberRoles are the roles a member can have in an organisation.
+var organisationMemberRoles = []string{"admin", "billing", "member"}
+
+func NewOrganisationMemberResource() resource.Resource {
+	return &OrganisationMemberResource{}
+}
+
+// OrganisationMemberResource defines the resource implementation.
+type OrganisationMemberResource struct {
+	client         *http.Client
+	endpoint       string
+	organisationId string
+}
+
+// OrganisationMemberResourceModel describes the resource data model.
+type OrganisationMemberResourceModel struct {
+	MemberUserModel
+	Id             types.String `tfsdk:"id"`
+	OrganisationId types.String `tfsdk:"organisation_id"`
+	Role           types.String `tfsdk:"role"`
+}
+
+// OrganisationMember represents the API response structure.
+type OrganisationMember struct {
+	Id             string     `json:"id"`
+	OrganisationId string     `json:"organisation_id"`
+	UserId         string     `json:"user_id"`
+	Role           string     `json:"role"`
+	User           MemberUser `json:"user"`
+}
+
+// OrganisationMemberCreateRequest represents the request body for adding a member to an organisation.
+type OrganisationMemberCreateRequest struct {
+	MemberUserCreateRequest
+	Role string `json:"role"`
+}
+
+// OrganisationMemberUpdateRequest represents the request body for changing the role of a member.
+type OrganisationMemberUpdateRequest struct {
+	Role string `json:"role"`
+}
+
+func (r *OrganisationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organisation_member"
+}
+
+func (r *OrganisationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := memberUserAttributes("organisation member")
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Organisation member identifier",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"organisation_id": schema.StringAttribute{
+			MarkdownDescription: "Organisation ID to which the member should be added. Defaults to the provider `organisation_id`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "Role of the member in the organisation, one of `admin`, `billing` or `member`",
+			Required:            true,
+		},
+	})
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A member of an organisation in the Switchcloud platform. The role decides whether the member can administer the organisation or manage its billing.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *OrganisationMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OrganisationMemberResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that either user_id or email is provided
+	resp.Diagnostics.Append(config.validate("an organisation member")...)
+
+	if !config.Role.IsNull() && !config.Role.IsUnknown() && !slices.Contains(organisationMemberRoles, config.Role.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Configuration Error",
+			fmt.Sprintf("'role' must be one of %s. Got: %s", strings.Join(organisationMemberRoles, ", "), config.Role.ValueString()),
+		)
+	}
+}
+
+func (r *OrganisationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	// The default organisation is optional
+	organisationId, _ := providerData["organisation_id"].(string)
+
+	r.client = client
+	r.endpoint = endpoint
+	r.organisationId = organisationId
+}
+
+func (r *OrganisationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganisationMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource organisation overrides the provider default
+	if data.OrganisationId.IsUnknown() || data.OrganisationId.IsNull() {
+		if r.organisationId == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organisation_id"),
+				"Missing Organisation",
+				"'organisation_id' must be set on the resource or on the provider.",
+			)
+			return
+		}
+		data.OrganisationId = types.StringValue(r.organisationId)
+	}
+
+	// Create API request body
+	createRequest := OrganisationMemberCreateRequest{
+		MemberUserCreateRequest: data.userCreateRequest(),
+		Role:                    data.Role.ValueString(),
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal create request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", r.membersUrl(data), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create organisation member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var organisationMember OrganisationMember
+	if err := json.Unmarshal(body, &organisationMember); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.update(organisationMember)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created an organisation member resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganisationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganisationMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", r.membersUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read organisation member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check if organisation member was deleted
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var organisationMember OrganisationMember
+	if err := json.Unmarshal(body, &organisationMember); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.update(organisationMember)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganisationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganisationMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can be changed in place
+	updateRequest := OrganisationMemberUpdateRequest{
+		Role: data.Role.ValueString(),
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal update request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", r.membersUrl(data)+"/"+data.Id.ValueString(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to update organisation member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var organisationMember OrganisationMember
+	if err := json.Unmarshal(body, &organisationMember); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.update(organisationMember)
+
+	tflog.Trace(ctx, "updated an organisation member resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganisationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganisationMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", r.membersUrl(data)+"/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete organisation member, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status, a member that is already gone is fine
+	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an organisation member resource")
+}
+
+func (r *OrganisationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is expected to be in the format: organisation_id/member_id
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: organisation_id/member_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *OrganisationMemberResource) membersUrl(data OrganisationMemberResourceModel) string {
+	return strings.TrimSuffix(r.endpoint, "/") + "/api/v1/organisations/" + data.OrganisationId.ValueString() + "/members"
+}
+
+// update sets the model from the API response.
+func (m *OrganisationMemberResourceModel) update(organisationMember OrganisationMember) {
+	m.Id = types.StringValue(organisationMember.Id)
+	m.OrganisationId = types.StringValue(organisationMember.OrganisationId)
+	m.Role = types.StringValue(organisationMember.Role)
+	m.MemberUserModel.update(organisationMember.UserId, organisationMember.User)
+}
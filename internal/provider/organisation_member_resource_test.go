@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganisationMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganisationMemberResourceConfig("owner"),
+				ExpectError: regexp.MustCompile("'role' must be one of admin, billing, member"),
+			},
+			{
+				Config: testAccOrganisationMemberResourceConfig("billing"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_organisation_member.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_organisation_member.test",
+						tfjsonpath.New("user_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_organisation_member.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("billing"),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_organisation_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["switchcloud_organisation_member.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["organisation_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testAccOrganisationMemberResourceConfig("admin"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_organisation_member.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("admin"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccOrganisationMemberResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "switchcloud_organisation_member" "test" {
+  organisation_id = "4d9f6b2e-8a3c-4e1f-b7d5-2c6a9e0f3b81"
+  email           = "admin@example.com"
+  role            = %[1]q
+}
+`, role)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganisationMembersDataSource{}
+
+func NewOrganisationMembersDataSource() datasource.DataSource {
+	return &OrganisationMembersDataSource{}
+}
+
+// OrganisationMembersDataSource defines the data source implementation.
+type OrganisationMembersDataSource struct {
+	client         *http.Client
+	endpoint       string
+	organisationId string
+}
+
+// OrganisationMembersDataSourceModel describes the data source data model.
+type OrganisationMembersDataSourceModel struct {
+	OrganisationId types.String              `tfsdk:"organisation_id"`
+	Role           types.String              `tfsdk:"role"`
+	Members        []OrganisationMemberModel `tfsdk:"members"`
+}
+
+// OrganisationMemberModel describes a member of an organisation.
+type OrganisationMemberModel struct {
+	Id          types.String `tfsdk:"id"`
+	UserId      types.String `tfsdk:"user_id"`
+	EMail       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+func (d *OrganisationMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organisation_members"
+}
+
+func (d *OrganisationMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Members of an organisation in the Switchcloud platform",
+
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				MarkdownDescription: "Organisation ID whose members are listed. Defaults to the provider `organisation_id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only list members with this role, one of `admin`, `billing` or `member`",
+				Optional:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Members of the organisation",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Organisation member identifier",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "User ID of the organisation member",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email of the organisation member",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the member in the organisation",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the organisation member",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganisationMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	// The default organisation is optional
+	organisationId, _ := providerData["organisation_id"].(string)
+
+	d.client = client
+	d.endpoint = endpoint
+	d.organisationId = organisationId
+}
+
+func (d *OrganisationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganisationMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The data source organisation overrides the provider default
+	if data.OrganisationId.IsNull() {
+		if d.organisationId == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organisation_id"),
+				"Missing Organisation",
+				"'organisation_id' must be set on the data source or on the provider.",
+			)
+			return
+		}
+		data.OrganisationId = types.StringValue(d.organisationId)
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(d.endpoint, "/")+"/api/v1/organisations/"+data.OrganisationId.ValueString()+"/members", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	if !data.Role.IsNull() {
+		query := httpReq.URL.Query()
+		query.Set("role", data.Role.ValueString())
+		httpReq.URL.RawQuery = query.Encode()
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read organisation members, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var organisationMembers []OrganisationMember
+	if err := json.Unmarshal(body, &organisationMembers); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.Members = make([]OrganisationMemberModel, 0, len(organisationMembers))
+	for _, organisationMember := range organisationMembers {
+		data.Members = append(data.Members, OrganisationMemberModel{
+			Id:          types.StringValue(organisationMember.Id),
+			UserId:      types.StringValue(organisationMember.UserId),
+			EMail:       types.StringValue(organisationMember.User.EMail),
+			Role:        types.StringValue(organisationMember.Role),
+			DisplayName: types.StringValue(organisationMember.User.DisplayName),
+		})
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read an organisation members data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganisationMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccOrganisationMembersDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_organisation_members.all",
+						tfjsonpath.New("members"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_organisation_members.billing",
+						tfjsonpath.New("members"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_organisation_members.billing",
+						tfjsonpath.New("members").AtSliceIndex(0).AtMapKey("email"),
+						knownvalue.StringExact("billing@example.com"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccOrganisationMembersDataSourceConfig = `
+resource "switchcloud_organisation_member" "admin" {
+  organisation_id = "9b2e5d8f-1c4a-4f7b-a6e3-0d8c2f5b7a19"
+  email           = "admin@example.com"
+  role            = "admin"
+}
+
+resource "switchcloud_organisation_member" "billing" {
+  organisation_id = "9b2e5d8f-1c4a-4f7b-a6e3-0d8c2f5b7a19"
+  email           = "billing@example.com"
+  role            = "billing"
+}
+
+data "switchcloud_organisation_members" "all" {
+  organisation_id = "9b2e5d8f-1c4a-4f7b-a6e3-0d8c2f5b7a19"
+
+  depends_on = [
+    switchcloud_organisation_member.admin,
+    switchcloud_organisation_member.billing,
+  ]
+}
+
+data "switchcloud_organisation_members" "billing" {
+  organisation_id = "9b2e5d8f-1c4a-4f7b-a6e3-0d8c2f5b7a19"
+  role            = "billing"
+
+  depends_on = [
+    switchcloud_organisation_member.admin,
+    switchcloud_organisation_member.billing,
+  ]
+}
+`
@@ -0,0 +1,549 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectBudgetResource{}
+var _ resource.ResourceWithImportState = &ProjectBudgetResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectBudgetResource{}
+
+// projectBudgetPeriods are the budget periods supported by the API.
+var projectBudgetPeriods = []string{"monthly", "quarterly", "yearly", "total"}
+
+// currencyCodePattern matches ISO 4217 currency codes.
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func NewProjectBudgetResource() resource.Resource {
+	return &ProjectBudgetResource{}
+}
+
+// ProjectBudgetResource defines the resource implementation.
+type ProjectBudgetResource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// ProjectBudgetResourceModel describes the resource data model.
+type ProjectBudgetResourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	ProjectId       types.String  `tfsdk:"project_id"`
+	Amount          types.Float64 `tfsdk:"amount"`
+	Currency        types.String  `tfsdk:"currency"`
+	Period          types.String  `tfsdk:"period"`
+	HardLimit       types.Bool    `tfsdk:"hard_limit"`
+	AlertThresholds types.List    `tfsdk:"alert_thresholds"`
+	CreatedAt       types.String  `tfsdk:"created_at"`
+	UpdatedAt       types.String  `tfsdk:"updated_at"`
+}
+
+// ProjectBudget represents the API response structure.
+type ProjectBudget struct {
+	Id              string  `json:"id"`
+	ProjectId       string  `json:"project_id"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	Period          string  `json:"period"`
+	HardLimit       bool    `json:"hard_limit"`
+	AlertThresholds []int64 `json:"alert_thresholds"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+// ProjectBudgetRequest represents the request body for creating or updating a project budget.
+type ProjectBudgetRequest struct {
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	Period          string  `json:"period"`
+	HardLimit       bool    `json:"hard_limit"`
+	AlertThresholds []int64 `json:"alert_thresholds"`
+}
+
+func (r *ProjectBudgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_budget"
+}
+
+func (r *ProjectBudgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A spending limit of a project in the Switchcloud platform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Project budget identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to which the budget applies.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"amount": schema.Float64Attribute{
+				MarkdownDescription: "Budget amount per period",
+				Required:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "ISO 4217 currency code of the amount. Defaults to `CHF`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("CHF"),
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "Budget period, one of `monthly`, `quarterly`, `yearly` or `total`",
+				Required:            true,
+			},
+			"hard_limit": schema.BoolAttribute{
+				MarkdownDescription: "Whether the budget is a hard limit that blocks further spending once reached. Soft limits only raise alerts. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"alert_thresholds": schema.ListAttribute{
+				MarkdownDescription: "Percentages of the amount at which budget alerts are raised, e.g. `[50, 80, 100]`",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the budget was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the budget was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectBudgetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectBudgetResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Amount.IsNull() && !config.Amount.IsUnknown() && config.Amount.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("amount"),
+			"Configuration Error",
+			"'amount' must be greater than zero.",
+		)
+	}
+
+	if !config.Currency.IsNull() && !config.Currency.IsUnknown() && !currencyCodePattern.MatchString(config.Currency.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("currency"),
+			"Configuration Error",
+			fmt.Sprintf("'currency' must be an ISO 4217 currency code such as CHF or EUR. Got: %s", config.Currency.ValueString()),
+		)
+	}
+
+	if !config.Period.IsNull() && !config.Period.IsUnknown() && !slices.Contains(projectBudgetPeriods, config.Period.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("period"),
+			"Configuration Error",
+			fmt.Sprintf("'period' must be one of %s. Got: %s", strings.Join(projectBudgetPeriods, ", "), config.Period.ValueString()),
+		)
+	}
+
+	if !config.AlertThresholds.IsNull() && !config.AlertThresholds.IsUnknown() {
+		var thresholds []types.Int64
+		resp.Diagnostics.Append(config.AlertThresholds.ElementsAs(ctx, &thresholds, false)...)
+
+		for _, threshold := range thresholds {
+			if threshold.IsUnknown() || threshold.IsNull() {
+				continue
+			}
+			if threshold.ValueInt64() < 1 || threshold.ValueInt64() > 100 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("alert_thresholds"),
+					"Configuration Error",
+					fmt.Sprintf("'alert_thresholds' must be percentages between 1 and 100. Got: %d", threshold.ValueInt64()),
+				)
+			}
+		}
+	}
+}
+
+func (r *ProjectBudgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	r.client = client
+	r.endpoint = endpoint
+}
+
+func (r *ProjectBudgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectBudgetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	createRequest := ProjectBudgetRequest{
+		Amount:          data.Amount.ValueFloat64(),
+		Currency:        data.Currency.ValueString(),
+		Period:          data.Period.ValueString(),
+		HardLimit:       data.HardLimit.ValueBool(),
+		AlertThresholds: []int64{},
+	}
+
+	if !data.AlertThresholds.IsNull() {
+		resp.Diagnostics.Append(data.AlertThresholds.ElementsAs(ctx, &createRequest.AlertThresholds, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal create request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+data.ProjectId.ValueString()+"/budgets", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create project budget, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var projectBudget ProjectBudget
+	if err := json.Unmarshal(body, &projectBudget); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, projectBudget)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a project budget resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectBudgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectBudgetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+data.ProjectId.ValueString()+"/budgets/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read project budget, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check if project budget was deleted
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var projectBudget ProjectBudget
+	if err := json.Unmarshal(body, &projectBudget); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, projectBudget)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectBudgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectBudgetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	updateRequest := ProjectBudgetRequest{
+		Amount:          data.Amount.ValueFloat64(),
+		Currency:        data.Currency.ValueString(),
+		Period:          data.Period.ValueString(),
+		HardLimit:       data.HardLimit.ValueBool(),
+		AlertThresholds: []int64{},
+	}
+
+	if !data.AlertThresholds.IsNull() {
+		resp.Diagnostics.Append(data.AlertThresholds.ElementsAs(ctx, &updateRequest.AlertThresholds, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal update request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+data.ProjectId.ValueString()+"/budgets/"+data.Id.ValueString(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to update project budget, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var projectBudget ProjectBudget
+	if err := json.Unmarshal(body, &projectBudget); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, projectBudget)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project budget resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectBudgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectBudgetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+data.ProjectId.ValueString()+"/budgets/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete project budget, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status, a budget that is already gone is fine
+	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project budget resource")
+}
+
+func (r *ProjectBudgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is expected to be in the format: project_id/budget_id
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: project_id/budget_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// update sets the model from the API response.
+func (m *ProjectBudgetResourceModel) update(ctx context.Context, projectBudget ProjectBudget) diag.Diagnostics {
+	m.Id = types.StringValue(projectBudget.Id)
+	m.ProjectId = types.StringValue(projectBudget.ProjectId)
+	m.Amount = types.Float64Value(projectBudget.Amount)
+	m.Currency = types.StringValue(projectBudget.Currency)
+	m.Period = types.StringValue(projectBudget.Period)
+	m.HardLimit = types.BoolValue(projectBudget.HardLimit)
+	m.CreatedAt = types.StringValue(projectBudget.CreatedAt)
+	m.UpdatedAt = types.StringValue(projectBudget.UpdatedAt)
+
+	// Keep an unset list unset if the API reports no thresholds
+	if len(projectBudget.AlertThresholds) == 0 && m.AlertThresholds.IsNull() {
+		return nil
+	}
+
+	alertThresholds, diags := types.ListValueFrom(ctx, types.Int64Type, projectBudget.AlertThresholds)
+	m.AlertThresholds = alertThresholds
+
+	return diags
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectBudgetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectBudgetResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("amount"),
+						knownvalue.Float64Exact(5000),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("currency"),
+						knownvalue.StringExact("CHF"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("hard_limit"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("alert_thresholds"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.Int64Exact(50),
+							knownvalue.Int64Exact(80),
+							knownvalue.Int64Exact(100),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_project_budget.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["switchcloud_project_budget.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["project_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testAccProjectBudgetResourceUpdateConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("amount"),
+						knownvalue.Float64Exact(7500.5),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("period"),
+						knownvalue.StringExact("yearly"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("hard_limit"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_budget.test",
+						tfjsonpath.New("alert_thresholds"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccProjectBudgetResourceConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_project_budget" "test" {
+  project_id       = switchcloud_project.test.id
+  amount           = 5000
+  period           = "monthly"
+  alert_thresholds = [50, 80, 100]
+}
+`
+
+const testAccProjectBudgetResourceUpdateConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_project_budget" "test" {
+  project_id = switchcloud_project.test.id
+  amount     = 7500.5
+  period     = "yearly"
+  hard_limit = true
+}
+`
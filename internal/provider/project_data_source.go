@@ -32,14 +32,22 @@ type ProjectDataSource struct {
 
 // ProjectDataSourceModel describes the data source data model.
 type ProjectDataSourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	OrganisationId types.String `tfsdk:"organisation_id"`
-	Archived       types.Bool   `tfsdk:"archived"`
-	ArchivedAt     types.String `tfsdk:"archived_at"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	OrganisationId        types.String `tfsdk:"organisation_id"`
+	BillingAccountId      types.String `tfsdk:"billing_account_id"`
+	PrincipalInvestigator types.String `tfsdk:"principal_investigator"`
+	TechnicalContact      types.String `tfsdk:"technical_contact"`
+	EndDate               types.String `tfsdk:"end_date"`
+	Purpose               types.String `tfsdk:"purpose"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+	Labels                types.Map    `tfsdk:"labels"`
+	OpenstackProjects     types.Map    `tfsdk:"openstack_projects"`
+	Archived              types.Bool   `tfsdk:"archived"`
+	ArchivedAt            types.String `tfsdk:"archived_at"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
 
 func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,6 +76,55 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "Organisation ID that owns this project",
 				Computed:            true,
 			},
+			"billing_account_id": schema.StringAttribute{
+				MarkdownDescription: "Billing account (cost centre) to which the project is billed",
+				Computed:            true,
+			},
+			"principal_investigator": schema.StringAttribute{
+				MarkdownDescription: "E-mail address of the principal investigator responsible for the project",
+				Computed:            true,
+			},
+			"technical_contact": schema.StringAttribute{
+				MarkdownDescription: "E-mail address of the technical contact of the project",
+				Computed:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Planned end of the project as an ISO 8601 date, recorded for IT governance",
+				Computed:            true,
+			},
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "Purpose of the project",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the project expires and should be archived, as an RFC 3339 timestamp",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels of the project, including those set through the provider `default_labels`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"openstack_projects": schema.MapNestedAttribute{
+				MarkdownDescription: "OpenStack projects backing this project, keyed by region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "OpenStack project ID in the region",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "OpenStack domain of the project",
+							Computed:            true,
+						},
+						"auth_url": schema.StringAttribute{
+							MarkdownDescription: "Keystone authentication URL of the region",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"archived": schema.BoolAttribute{
 				MarkdownDescription: "Whether the project is archived",
 				Computed:            true,
@@ -147,7 +204,7 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Make API call
 	httpResp, err := d.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read projects, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read projects, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -155,20 +212,30 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
 	// Parse response
 	var project Project
 	if err := json.Unmarshal(body, &project); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse response, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	openstackProjects, diags := openstackProjectsValue(project.OpenstackProjects)
+	resp.Diagnostics.Append(diags...)
+
+	labels, diags := types.MapValueFrom(ctx, types.StringType, project.Labels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -177,6 +244,14 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	data.Name = types.StringValue(project.Name)
 	data.Description = types.StringPointerValue(project.Description)
 	data.OrganisationId = types.StringValue(project.OrganisationId)
+	data.BillingAccountId = types.StringPointerValue(project.BillingAccountId)
+	data.PrincipalInvestigator = types.StringPointerValue(project.PrincipalInvestigator)
+	data.TechnicalContact = types.StringPointerValue(project.TechnicalContact)
+	data.EndDate = types.StringPointerValue(project.EndDate)
+	data.Purpose = types.StringPointerValue(project.Purpose)
+	data.ExpiresAt = types.StringPointerValue(project.ExpiresAt)
+	data.Labels = labels
+	data.OpenstackProjects = openstackProjects
 	data.Archived = types.BoolValue(project.Archived)
 	data.ArchivedAt = types.StringValue(project.ArchivedAt)
 	data.CreatedAt = types.StringValue(project.CreatedAt)
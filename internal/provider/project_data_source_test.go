@@ -31,6 +31,21 @@ func TestAccProjectDataSource(t *testing.T) {
 						tfjsonpath.New("name"),
 						knownvalue.StringExact("test1"),
 					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project.test",
+						tfjsonpath.New("openstack_projects").AtMapKey("ZH").AtMapKey("project_id"),
+						knownvalue.StringExact("5b9e3c1f0a7d4e2b8c6f1a3d9e7b5c2a"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project.test",
+						tfjsonpath.New("openstack_projects").AtMapKey("LS").AtMapKey("auth_url"),
+						knownvalue.StringExact("https://ls.cloud.switch.ch:5000/v3"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project.test",
+						tfjsonpath.New("labels").AtMapKey("department"),
+						knownvalue.StringExact("Informatikdienste"),
+					),
 				},
 			},
 		},
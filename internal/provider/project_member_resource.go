@@ -9,13 +9,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,6 +29,9 @@ var _ resource.Resource = &ProjectMemberResource{}
 var _ resource.ResourceWithImportState = &ProjectMemberResource{}
 var _ resource.ResourceWithValidateConfig = &ProjectMemberResource{}
 
+// projectMemberRoles are the roles a member can have in a project.
+var projectMemberRoles = []string{"owner", "member", "reader"}
+
 func NewProjectMemberResource() resource.Resource {
 	return &ProjectMemberResource{}
 }
@@ -38,30 +44,30 @@ type ProjectMemberResource struct {
 
 // ProjectResourceModel describes the resource data model.
 type ProjectMemberResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	ProjectId   types.String `tfsdk:"project_id"`
-	UserId      types.String `tfsdk:"user_id"`
-	EMail       types.String `tfsdk:"email"`
-	DisplayName types.String `tfsdk:"display_name"`
+	MemberUserModel
+	Id               types.String `tfsdk:"id"`
+	ProjectId        types.String `tfsdk:"project_id"`
+	ServiceAccountId types.String `tfsdk:"service_account_id"`
+	GroupId          types.String `tfsdk:"group_id"`
+	Role             types.String `tfsdk:"role"`
 }
 
 // ProjectMember represents the API response structure.
 type ProjectMember struct {
-	Id        string            `json:"id"`
-	ProjectId string            `json:"project_id"`
-	UserId    string            `json:"user_id"`
-	User      ProjectMemberUser `json:"user"`
-}
-
-type ProjectMemberUser struct {
-	Id          string `json:"id"`
-	EMail       string `json:"email"`
-	DisplayName string `json:"display_name"`
+	Id               string     `json:"id"`
+	ProjectId        string     `json:"project_id"`
+	UserId           string     `json:"user_id"`
+	ServiceAccountId *string    `json:"service_account_id,omitempty"`
+	GroupId          *string    `json:"group_id,omitempty"`
+	Role             string     `json:"role"`
+	User             MemberUser `json:"user"`
 }
 
 type ProjectMemberCreateRequest struct {
-	UserId string `json:"user_id,omitempty"`
-	EMail  string `json:"email,omitempty"`
+	MemberUserCreateRequest
+	ServiceAccountId string `json:"service_account_id,omitempty"`
+	GroupId          string `json:"group_id,omitempty"`
+	Role             string `json:"role"`
 }
 
 func (r *ProjectMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,46 +75,51 @@ func (r *ProjectMemberResource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *ProjectMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "A member of a project in the Switchcloud platform.",
-
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "Project member identifier",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+	attributes := memberUserAttributes("project member")
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Project member identifier",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"project_id": schema.StringAttribute{
-				MarkdownDescription: "Project ID to which the member should be managed.",
-				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+		},
+		"project_id": schema.StringAttribute{
+			MarkdownDescription: "Project ID to which the member should be managed.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"user_id": schema.StringAttribute{
-				MarkdownDescription: "User ID of the project member",
-				Optional:            true,
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+		},
+		"service_account_id": schema.StringAttribute{
+			MarkdownDescription: "Service account ID of the project member",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"email": schema.StringAttribute{
-				MarkdownDescription: "Email of the project member",
-				Optional:            true,
-				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+		},
+		"group_id": schema.StringAttribute{
+			MarkdownDescription: "Group ID of the project member. All members of the group are granted access to the project.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"display_name": schema.StringAttribute{
-				MarkdownDescription: "Display name of the project member",
-				Computed:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "Role of the member in the project, one of `owner`, `member` or `reader`. Defaults to `member`. Changing the role removes the member and adds it again.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("member"),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
 		},
+	})
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A member of a project in the Switchcloud platform.",
+		Attributes:          attributes,
 	}
 }
 
@@ -122,18 +133,17 @@ func (r *ProjectMemberResource) ValidateConfig(ctx context.Context, req resource
 		return
 	}
 
-	// Validate that either user_id or email is provided
-	if config.UserId.IsNull() && config.EMail.IsNull() {
-		resp.Diagnostics.AddError(
-			"Configuration Error",
-			"Either 'user_id' or 'email' must be provided for a project member.",
-		)
-	}
+	// Validate that exactly one of user_id, email, service_account_id or group_id is provided
+	resp.Diagnostics.Append(config.validate("a project member",
+		memberAlternative{name: "service_account_id", value: config.ServiceAccountId},
+		memberAlternative{name: "group_id", value: config.GroupId},
+	)...)
 
-	if !config.UserId.IsNull() && !config.EMail.IsNull() {
-		resp.Diagnostics.AddError(
+	if !config.Role.IsNull() && !config.Role.IsUnknown() && !slices.Contains(projectMemberRoles, config.Role.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
 			"Configuration Error",
-			"Only one of 'user_id' or 'email' can be provided for a project member.",
+			fmt.Sprintf("'role' must be one of %s. Got: %s", strings.Join(projectMemberRoles, ", "), config.Role.ValueString()),
 		)
 	}
 }
@@ -186,11 +196,14 @@ func (r *ProjectMemberResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Create API request body
-	createRequest := ProjectMemberCreateRequest{}
-	if data.UserId.IsUnknown() {
-		createRequest.EMail = data.EMail.ValueString()
-	} else {
-		createRequest.UserId = data.UserId.ValueString()
+	createRequest := ProjectMemberCreateRequest{Role: data.Role.ValueString()}
+	switch {
+	case !data.ServiceAccountId.IsNull():
+		createRequest.ServiceAccountId = data.ServiceAccountId.ValueString()
+	case !data.GroupId.IsNull():
+		createRequest.GroupId = data.GroupId.ValueString()
+	default:
+		createRequest.MemberUserCreateRequest = data.userCreateRequest()
 	}
 
 	// Marshal request body
@@ -213,7 +226,7 @@ func (r *ProjectMemberResource) Create(ctx context.Context, req resource.CreateR
 	// Make API call
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project member, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create project member, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -221,29 +234,25 @@ func (r *ProjectMemberResource) Create(ctx context.Context, req resource.CreateR
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusCreated {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
 	// Parse response
 	var projectMember ProjectMember
 	if err := json.Unmarshal(body, &projectMember); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse response, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
 		return
 	}
 
 	// Update model with response data
-	data.Id = types.StringValue(projectMember.Id)
-	data.ProjectId = types.StringValue(projectMember.ProjectId)
-	data.UserId = types.StringValue(projectMember.UserId)
-	data.EMail = types.StringValue(projectMember.User.EMail)
-	data.DisplayName = types.StringValue(projectMember.User.DisplayName)
+	data.update(projectMember)
 
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a project member resource")
@@ -274,7 +283,7 @@ func (r *ProjectMemberResource) Read(ctx context.Context, req resource.ReadReque
 	// Make API call
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read project, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -282,7 +291,7 @@ func (r *ProjectMemberResource) Read(ctx context.Context, req resource.ReadReque
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
@@ -294,23 +303,19 @@ func (r *ProjectMemberResource) Read(ctx context.Context, req resource.ReadReque
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
 	// Parse response
 	var projectMember ProjectMember
 	if err := json.Unmarshal(body, &projectMember); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse response, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
 		return
 	}
 
 	// Update model with response data
-	data.Id = types.StringValue(projectMember.Id)
-	data.ProjectId = types.StringValue(projectMember.ProjectId)
-	data.UserId = types.StringValue(projectMember.UserId)
-	data.EMail = types.StringValue(projectMember.User.EMail)
-	data.DisplayName = types.StringValue(projectMember.User.DisplayName)
+	data.update(projectMember)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -342,7 +347,7 @@ func (r *ProjectMemberResource) Delete(ctx context.Context, req resource.DeleteR
 	// Make API call
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project member, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete project member, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -350,13 +355,13 @@ func (r *ProjectMemberResource) Delete(ctx context.Context, req resource.DeleteR
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusNoContent {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
@@ -393,7 +398,7 @@ func (r *ProjectMemberResource) ImportState(ctx context.Context, req resource.Im
 	// Make API call
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read project, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -401,7 +406,7 @@ func (r *ProjectMemberResource) ImportState(ctx context.Context, req resource.Im
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
@@ -413,24 +418,36 @@ func (r *ProjectMemberResource) ImportState(ctx context.Context, req resource.Im
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
 	// Parse response
 	var projectMember ProjectMember
 	if err := json.Unmarshal(body, &projectMember); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse response, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
 		return
 	}
 
 	// Update model with response data
-	data.Id = types.StringValue(projectMember.Id)
-	data.ProjectId = types.StringValue(projectMember.ProjectId)
-	data.UserId = types.StringValue(projectMember.UserId)
-	data.EMail = types.StringValue(projectMember.User.EMail)
-	data.DisplayName = types.StringValue(projectMember.User.DisplayName)
+	data.update(projectMember)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// update sets the model from the API response. Service accounts and groups have
+// no user ID or e-mail address, so these are left empty for them.
+func (m *ProjectMemberResourceModel) update(projectMember ProjectMember) {
+	m.Id = types.StringValue(projectMember.Id)
+	m.ProjectId = types.StringValue(projectMember.ProjectId)
+	m.ServiceAccountId = types.StringPointerValue(projectMember.ServiceAccountId)
+	m.GroupId = types.StringPointerValue(projectMember.GroupId)
+	m.Role = types.StringValue(projectMember.Role)
+	m.MemberUserModel.update(projectMember.UserId, projectMember.User)
+
+	if projectMember.ServiceAccountId != nil || projectMember.GroupId != nil {
+		m.UserId = types.StringNull()
+		m.EMail = types.StringNull()
+	}
+}
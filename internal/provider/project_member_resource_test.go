@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,6 +21,10 @@ func TestAccProjectMemberResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectMemberResourceInvalidRoleConfig,
+				ExpectError: regexp.MustCompile("'role' must be one of owner, member, reader. Got: admin"),
+			},
 			{
 				Config: testAccProjectMemberResourceConfig,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -33,6 +38,11 @@ func TestAccProjectMemberResource(t *testing.T) {
 						tfjsonpath.New("user_id"),
 						knownvalue.StringExact("user-12345"),
 					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("member"),
+					),
 					statecheck.ExpectKnownValue(
 						"switchcloud_project_member.test",
 						tfjsonpath.New("display_name"),
@@ -68,6 +78,73 @@ func TestAccProjectMemberResource(t *testing.T) {
 						tfjsonpath.New("email"),
 						knownvalue.StringExact("user@example.com"),
 					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("role"),
+						knownvalue.StringExact("reader"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectMemberResourceServiceAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectMemberResourceAmbiguousConfig,
+				ExpectError: regexp.MustCompile("Only one of 'user_id', 'email', 'service_account_id' or 'group_id'"),
+			},
+			{
+				Config: testAccProjectMemberResourceServiceAccountConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("service_account_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("display_name"),
+						knownvalue.StringExact("ci"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("user_id"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectMemberResourceGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMemberResourceGroupConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("group_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("display_name"),
+						knownvalue.StringExact("researchers"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_member.test",
+						tfjsonpath.New("email"),
+						knownvalue.Null(),
+					),
 				},
 			},
 		},
@@ -93,5 +170,52 @@ resource "switchcloud_project" "test2" {
 resource "switchcloud_project_member" "test" {
   project_id = switchcloud_project.test2.id
   email      = "user@example.com"
+  role       = "reader"
+}
+`
+
+const testAccProjectMemberResourceInvalidRoleConfig = `
+resource "switchcloud_project_member" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  user_id    = "user-12345"
+  role       = "admin"
+}
+`
+
+const testAccProjectMemberResourceAmbiguousConfig = `
+resource "switchcloud_project_member" "test" {
+  project_id         = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  email              = "user@example.com"
+  service_account_id = "7c1e9a4f-2b6d-4f80-9e3a-5d8c1b7f2e64"
+}
+`
+
+const testAccProjectMemberResourceServiceAccountConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_service_account" "test" {
+  name = "ci"
+}
+
+resource "switchcloud_project_member" "test" {
+  project_id         = switchcloud_project.test.id
+  service_account_id = switchcloud_service_account.test.id
+}
+`
+
+const testAccProjectMemberResourceGroupConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_group" "test" {
+  name = "researchers"
+}
+
+resource "switchcloud_project_member" "test" {
+  project_id = switchcloud_project.test.id
+  group_id   = switchcloud_group.test.id
 }
 `
@@ -0,0 +1,607 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectQuotaResource{}
+var _ resource.ResourceWithImportState = &ProjectQuotaResource{}
+
+// Quota change request states reported by the API.
+const (
+	quotaChangeRequestPending  = "pending"
+	quotaChangeRequestRejected = "rejected"
+)
+
+// quotaValuesAttrTypes are the attribute types of the current quota and usage objects.
+var quotaValuesAttrTypes = map[string]attr.Type{
+	"instances":         types.Int64Type,
+	"cores":             types.Int64Type,
+	"ram_mb":            types.Int64Type,
+	"volumes":           types.Int64Type,
+	"floating_ips":      types.Int64Type,
+	"object_storage_gb": types.Int64Type,
+}
+
+func NewProjectQuotaResource() resource.Resource {
+	return &ProjectQuotaResource{}
+}
+
+// ProjectQuotaResource defines the resource implementation.
+type ProjectQuotaResource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// ProjectQuotaResourceModel describes the resource data model.
+type ProjectQuotaResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ProjectId       types.String `tfsdk:"project_id"`
+	Region          types.String `tfsdk:"region"`
+	Instances       types.Int64  `tfsdk:"instances"`
+	Cores           types.Int64  `tfsdk:"cores"`
+	RamMb           types.Int64  `tfsdk:"ram_mb"`
+	Volumes         types.Int64  `tfsdk:"volumes"`
+	FloatingIps     types.Int64  `tfsdk:"floating_ips"`
+	ObjectStorageGb types.Int64  `tfsdk:"object_storage_gb"`
+	Reason          types.String `tfsdk:"reason"`
+	Status          types.String `tfsdk:"status"`
+	ChangeRequestId types.String `tfsdk:"change_request_id"`
+	Current         types.Object `tfsdk:"current"`
+	Usage           types.Object `tfsdk:"usage"`
+}
+
+// ProjectQuota represents the API response structure.
+type ProjectQuota struct {
+	ProjectId     string              `json:"project_id"`
+	Region        string              `json:"region"`
+	Quota         QuotaValues         `json:"quota"`
+	Usage         QuotaValues         `json:"usage"`
+	ChangeRequest *QuotaChangeRequest `json:"change_request,omitempty"`
+}
+
+// QuotaValues represents the limits or usage of a project in a region.
+type QuotaValues struct {
+	Instances       int64 `json:"instances"`
+	Cores           int64 `json:"cores"`
+	RamMb           int64 `json:"ram_mb"`
+	Volumes         int64 `json:"volumes"`
+	FloatingIps     int64 `json:"floating_ips"`
+	ObjectStorageGb int64 `json:"object_storage_gb"`
+}
+
+// QuotaChangeRequest represents a request to change the quota of a project in a region.
+type QuotaChangeRequest struct {
+	Id        string                   `json:"id"`
+	Status    string                   `json:"status"`
+	Reason    *string                  `json:"reason,omitempty"`
+	Quota     QuotaChangeRequestValues `json:"quota"`
+	CreatedAt string                   `json:"created_at"`
+}
+
+// QuotaChangeCreateRequest represents the request body for submitting a quota change request.
+type QuotaChangeCreateRequest struct {
+	Quota  QuotaChangeRequestValues `json:"quota"`
+	Reason *string                  `json:"reason,omitempty"`
+}
+
+// QuotaChangeRequestValues represents the requested limits, unset limits remain unchanged.
+type QuotaChangeRequestValues struct {
+	Instances       *int64 `json:"instances,omitempty"`
+	Cores           *int64 `json:"cores,omitempty"`
+	RamMb           *int64 `json:"ram_mb,omitempty"`
+	Volumes         *int64 `json:"volumes,omitempty"`
+	FloatingIps     *int64 `json:"floating_ips,omitempty"`
+	ObjectStorageGb *int64 `json:"object_storage_gb,omitempty"`
+}
+
+func (r *ProjectQuotaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_quota"
+}
+
+func (r *ProjectQuotaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	quotaAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description + ". Defaults to the current quota if not set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	quotaValuesAttributes := func(description string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"instances": schema.Int64Attribute{
+				MarkdownDescription: description + " instances",
+				Computed:            true,
+			},
+			"cores": schema.Int64Attribute{
+				MarkdownDescription: description + " vCPU cores",
+				Computed:            true,
+			},
+			"ram_mb": schema.Int64Attribute{
+				MarkdownDescription: description + " RAM in MB",
+				Computed:            true,
+			},
+			"volumes": schema.Int64Attribute{
+				MarkdownDescription: description + " volumes",
+				Computed:            true,
+			},
+			"floating_ips": schema.Int64Attribute{
+				MarkdownDescription: description + " floating IPs",
+				Computed:            true,
+			},
+			"object_storage_gb": schema.Int64Attribute{
+				MarkdownDescription: description + " object storage in GB",
+				Computed:            true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The OpenStack resource quota of a project in a region of the Switchcloud platform. " +
+			"Changes are submitted as quota change requests, which may need to be approved by the SwitchCloud operators. " +
+			"While a request is pending the requested values are kept in the state. Destroying the resource leaves the quota unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Project quota identifier in the format `project_id/region`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID to which the quota applies.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region to which the quota applies.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instances":         quotaAttribute("Maximum number of instances"),
+			"cores":             quotaAttribute("Maximum number of vCPU cores"),
+			"ram_mb":            quotaAttribute("Maximum RAM in MB"),
+			"volumes":           quotaAttribute("Maximum number of volumes"),
+			"floating_ips":      quotaAttribute("Maximum number of floating IPs"),
+			"object_storage_gb": quotaAttribute("Maximum object storage in GB"),
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Justification sent along with quota change requests, shown to the SwitchCloud operators when a request needs approval",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the latest quota change request, one of `pending`, `approved` or `rejected`",
+				Computed:            true,
+			},
+			"change_request_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the latest quota change request",
+				Computed:            true,
+			},
+			"current": schema.SingleNestedAttribute{
+				MarkdownDescription: "Quota currently in effect",
+				Computed:            true,
+				Attributes:          quotaValuesAttributes("Limit for"),
+			},
+			"usage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Current resource usage",
+				Computed:            true,
+				Attributes:          quotaValuesAttributes("Used"),
+			},
+		},
+	}
+}
+
+func (r *ProjectQuotaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	r.client = client
+	r.endpoint = endpoint
+}
+
+func (r *ProjectQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectQuotaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a project quota resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectQuotaResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectQuota, diags := r.getQuota(ctx, data.ProjectId.ValueString(), data.Region.ValueString())
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if project or region was deleted
+	if projectQuota == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, *projectQuota)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectQuotaResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project quota resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning("Quota Unchanged", "Project quotas cannot be deleted, the quota remains in effect and is no longer managed by Terraform")
+
+	tflog.Trace(ctx, "deleted a project quota resource")
+}
+
+func (r *ProjectQuotaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is expected to be in the format: project_id/region
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: project_id/region. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), parts[1])...)
+}
+
+// apply submits a quota change request for all configured values that differ from
+// the current quota and updates the model with the resulting quota.
+func (r *ProjectQuotaResource) apply(ctx context.Context, data *ProjectQuotaResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	projectQuota, d := r.getQuota(ctx, data.ProjectId.ValueString(), data.Region.ValueString())
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if projectQuota == nil {
+		diags.AddError("Client Error", fmt.Sprintf("No quota found for project %s in region %s", data.ProjectId.ValueString(), data.Region.ValueString()))
+		return diags
+	}
+
+	// Only request the values that differ from the current quota
+	changed := false
+	requested := func(planned types.Int64, current int64) *int64 {
+		if planned.IsUnknown() || planned.IsNull() || planned.ValueInt64() == current {
+			return nil
+		}
+		changed = true
+		return planned.ValueInt64Pointer()
+	}
+
+	changeRequest := QuotaChangeRequestValues{
+		Instances:       requested(data.Instances, projectQuota.Quota.Instances),
+		Cores:           requested(data.Cores, projectQuota.Quota.Cores),
+		RamMb:           requested(data.RamMb, projectQuota.Quota.RamMb),
+		Volumes:         requested(data.Volumes, projectQuota.Quota.Volumes),
+		FloatingIps:     requested(data.FloatingIps, projectQuota.Quota.FloatingIps),
+		ObjectStorageGb: requested(data.ObjectStorageGb, projectQuota.Quota.ObjectStorageGb),
+	}
+
+	if changed {
+		quotaChangeRequest, d := r.requestQuotaChange(ctx, data.ProjectId.ValueString(), data.Region.ValueString(), QuotaChangeCreateRequest{
+			Quota:  changeRequest,
+			Reason: data.Reason.ValueStringPointer(),
+		})
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if quotaChangeRequest.Status == quotaChangeRequestRejected {
+			reason := "no reason given"
+			if quotaChangeRequest.Reason != nil {
+				reason = *quotaChangeRequest.Reason
+			}
+			diags.AddError(
+				"Quota Change Request Rejected",
+				fmt.Sprintf("Quota change request %s for project %s in region %s was rejected: %s", quotaChangeRequest.Id, data.ProjectId.ValueString(), data.Region.ValueString(), reason),
+			)
+			return diags
+		}
+
+		tflog.Debug(ctx, "submitted a quota change request", map[string]interface{}{
+			"change_request_id": quotaChangeRequest.Id,
+			"status":            quotaChangeRequest.Status,
+		})
+
+		// Read the quota again to pick up approved changes
+		projectQuota, d = r.getQuota(ctx, data.ProjectId.ValueString(), data.Region.ValueString())
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if projectQuota == nil {
+			diags.AddError("Client Error", fmt.Sprintf("No quota found for project %s in region %s", data.ProjectId.ValueString(), data.Region.ValueString()))
+			return diags
+		}
+	}
+
+	diags.Append(data.update(ctx, *projectQuota)...)
+
+	return diags
+}
+
+// getQuota returns the quota of the project in the region, or nil if it does not exist.
+func (r *ProjectQuotaResource) getQuota(ctx context.Context, projectId string, region string) (*ProjectQuota, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+projectId+"/quotas/"+region, nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return nil, diags
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read project quota, got error: %s", err)))
+		return nil, diags
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return nil, diags
+	}
+
+	// Check if project or region does not exist
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, diags
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return nil, diags
+	}
+
+	// Parse response
+	var projectQuota ProjectQuota
+	if err := json.Unmarshal(body, &projectQuota); err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return nil, diags
+	}
+
+	return &projectQuota, diags
+}
+
+// requestQuotaChange submits a quota change request for the project in the region.
+func (r *ProjectQuotaResource) requestQuotaChange(ctx context.Context, projectId string, region string, changeRequest QuotaChangeCreateRequest) (*QuotaChangeRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(changeRequest)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to marshal quota change request, got error: %s", err))
+		return nil, diags
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+projectId+"/quotas/"+region+"/requests", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return nil, diags
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to request quota change, got error: %s", err)))
+		return nil, diags
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return nil, diags
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return nil, diags
+	}
+
+	// Parse response
+	var quotaChangeRequest QuotaChangeRequest
+	if err := json.Unmarshal(body, &quotaChangeRequest); err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return nil, diags
+	}
+
+	return &quotaChangeRequest, diags
+}
+
+// update sets the model from the API response. While a change request is pending
+// the requested values are reported instead of the current quota, so that the
+// request is not submitted again.
+func (m *ProjectQuotaResourceModel) update(ctx context.Context, projectQuota ProjectQuota) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	quota := projectQuota.Quota
+
+	m.Id = types.StringValue(projectQuota.ProjectId + "/" + projectQuota.Region)
+	m.ProjectId = types.StringValue(projectQuota.ProjectId)
+	m.Region = types.StringValue(projectQuota.Region)
+	m.Status = types.StringNull()
+	m.ChangeRequestId = types.StringNull()
+
+	if changeRequest := projectQuota.ChangeRequest; changeRequest != nil {
+		m.Status = types.StringValue(changeRequest.Status)
+		m.ChangeRequestId = types.StringValue(changeRequest.Id)
+
+		if changeRequest.Status == quotaChangeRequestPending {
+			requested := func(value *int64, current int64) int64 {
+				if value == nil {
+					return current
+				}
+				return *value
+			}
+			quota = QuotaValues{
+				Instances:       requested(changeRequest.Quota.Instances, quota.Instances),
+				Cores:           requested(changeRequest.Quota.Cores, quota.Cores),
+				RamMb:           requested(changeRequest.Quota.RamMb, quota.RamMb),
+				Volumes:         requested(changeRequest.Quota.Volumes, quota.Volumes),
+				FloatingIps:     requested(changeRequest.Quota.FloatingIps, quota.FloatingIps),
+				ObjectStorageGb: requested(changeRequest.Quota.ObjectStorageGb, quota.ObjectStorageGb),
+			}
+		}
+	}
+
+	m.Instances = types.Int64Value(quota.Instances)
+	m.Cores = types.Int64Value(quota.Cores)
+	m.RamMb = types.Int64Value(quota.RamMb)
+	m.Volumes = types.Int64Value(quota.Volumes)
+	m.FloatingIps = types.Int64Value(quota.FloatingIps)
+	m.ObjectStorageGb = types.Int64Value(quota.ObjectStorageGb)
+
+	var d diag.Diagnostics
+	m.Current, d = quotaValuesObject(projectQuota.Quota)
+	diags.Append(d...)
+	m.Usage, d = quotaValuesObject(projectQuota.Usage)
+	diags.Append(d...)
+
+	return diags
+}
+
+// quotaValuesObject converts quota values into a Terraform object value.
+func quotaValuesObject(values QuotaValues) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(quotaValuesAttrTypes, map[string]attr.Value{
+		"instances":         types.Int64Value(values.Instances),
+		"cores":             types.Int64Value(values.Cores),
+		"ram_mb":            types.Int64Value(values.RamMb),
+		"volumes":           types.Int64Value(values.Volumes),
+		"floating_ips":      types.Int64Value(values.FloatingIps),
+		"object_storage_gb": types.Int64Value(values.ObjectStorageGb),
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectQuotaResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectQuotaResourceConfig(32, 10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("cores"),
+						knownvalue.Int64Exact(32),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("ram_mb"),
+						knownvalue.Int64Exact(51200),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("approved"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("current").AtMapKey("cores"),
+						knownvalue.Int64Exact(32),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("usage").AtMapKey("cores"),
+						knownvalue.Int64Exact(4),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_project_quota.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The reason is only sent with change requests and cannot be read back
+				ImportStateVerifyIgnore: []string{"reason"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["switchcloud_project_quota.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["project_id"] + "/" + rs.Primary.Attributes["region"], nil
+				},
+			},
+			// Requests above 512 cores remain pending, the requested value is kept in the state
+			{
+				Config: testAccProjectQuotaResourceConfig(1024, 10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("cores"),
+						knownvalue.Int64Exact(1024),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("pending"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project_quota.test",
+						tfjsonpath.New("current").AtMapKey("cores"),
+						knownvalue.Int64Exact(32),
+					),
+				},
+			},
+			// Requests above 1000 instances are rejected
+			{
+				Config:      testAccProjectQuotaResourceConfig(1024, 5000),
+				ExpectError: regexp.MustCompile("Quota Change Request Rejected"),
+			},
+		},
+	})
+}
+
+func testAccProjectQuotaResourceConfig(cores int, instances int) string {
+	return fmt.Sprintf(`
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_project_quota" "test" {
+  project_id = switchcloud_project.test.id
+  region     = "ZH"
+  cores      = %[1]d
+  instances  = %[2]d
+  reason     = "Load tests for the spring release"
+}
+`, cores, instances)
+}
@@ -9,12 +9,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,6 +29,15 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectResource{}
+
+// openstackProjectAttrTypes are the attribute types of an OpenStack project linked to a project.
+var openstackProjectAttrTypes = map[string]attr.Type{
+	"project_id": types.StringType,
+	"domain":     types.StringType,
+	"auth_url":   types.StringType,
+}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -31,44 +45,93 @@ func NewProjectResource() resource.Resource {
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client   *http.Client
-	endpoint string
+	client         *http.Client
+	endpoint       string
+	organisationId string
+	defaultLabels  map[string]string
+	// expiryWarningDays is how many days before `expires_at` a warning is shown in plans
+	expiryWarningDays int64
 }
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	OrganisationId types.String `tfsdk:"organisation_id"`
-	Archived       types.Bool   `tfsdk:"archived"`
-	ArchivedAt     types.String `tfsdk:"archived_at"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	OrganisationId        types.String `tfsdk:"organisation_id"`
+	AllowTransfer         types.Bool   `tfsdk:"allow_transfer"`
+	BillingAccountId      types.String `tfsdk:"billing_account_id"`
+	PrincipalInvestigator types.String `tfsdk:"principal_investigator"`
+	TechnicalContact      types.String `tfsdk:"technical_contact"`
+	EndDate               types.String `tfsdk:"end_date"`
+	Purpose               types.String `tfsdk:"purpose"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+	Labels                types.Map    `tfsdk:"labels"`
+	EffectiveLabels       types.Map    `tfsdk:"effective_labels"`
+	OpenstackProjects     types.Map    `tfsdk:"openstack_projects"`
+	Archived              types.Bool   `tfsdk:"archived"`
+	ArchivedAt            types.String `tfsdk:"archived_at"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
 
 // Project represents the API response structure.
 type Project struct {
-	Id             string  `json:"id"`
-	Name           string  `json:"name"`
-	Description    *string `json:"description,omitempty"`
-	OrganisationId string  `json:"organisation_id"`
-	Archived       bool    `json:"archived"`
-	ArchivedAt     string  `json:"archived_at,omitempty"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
+	Id                    string                      `json:"id"`
+	Name                  string                      `json:"name"`
+	Description           *string                     `json:"description,omitempty"`
+	OrganisationId        string                      `json:"organisation_id"`
+	BillingAccountId      *string                     `json:"billing_account_id,omitempty"`
+	PrincipalInvestigator *string                     `json:"principal_investigator,omitempty"`
+	TechnicalContact      *string                     `json:"technical_contact,omitempty"`
+	EndDate               *string                     `json:"end_date,omitempty"`
+	Purpose               *string                     `json:"purpose,omitempty"`
+	ExpiresAt             *string                     `json:"expires_at,omitempty"`
+	Labels                map[string]string           `json:"labels,omitempty"`
+	OpenstackProjects     map[string]OpenstackProject `json:"openstack_projects,omitempty"`
+	Archived              bool                        `json:"archived"`
+	ArchivedAt            string                      `json:"archived_at,omitempty"`
+	CreatedAt             string                      `json:"created_at"`
+	UpdatedAt             string                      `json:"updated_at"`
+}
+
+// OpenstackProject represents the OpenStack project backing a project in a region.
+type OpenstackProject struct {
+	ProjectId string `json:"project_id"`
+	Domain    string `json:"domain"`
+	AuthUrl   string `json:"auth_url"`
 }
 
 // ProjectCreateRequest represents the request body for creating a project.
 type ProjectCreateRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
+	Name                  string            `json:"name"`
+	Description           string            `json:"description,omitempty"`
+	OrganisationId        string            `json:"organisation_id,omitempty"`
+	BillingAccountId      string            `json:"billing_account_id,omitempty"`
+	PrincipalInvestigator string            `json:"principal_investigator,omitempty"`
+	TechnicalContact      string            `json:"technical_contact,omitempty"`
+	EndDate               string            `json:"end_date,omitempty"`
+	Purpose               string            `json:"purpose,omitempty"`
+	ExpiresAt             string            `json:"expires_at,omitempty"`
+	Labels                map[string]string `json:"labels,omitempty"`
+}
+
+// ProjectTransferRequest represents the request body for transferring a project to another organisation.
+type ProjectTransferRequest struct {
+	OrganisationId string `json:"organisation_id"`
 }
 
 // ProjectUpdateRequest represents the request body for updating a project.
 type ProjectUpdateRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
+	Name                  string            `json:"name"`
+	Description           string            `json:"description,omitempty"`
+	BillingAccountId      *string           `json:"billing_account_id"`
+	PrincipalInvestigator *string           `json:"principal_investigator"`
+	TechnicalContact      *string           `json:"technical_contact"`
+	EndDate               *string           `json:"end_date"`
+	Purpose               *string           `json:"purpose"`
+	ExpiresAt             *string           `json:"expires_at"`
+	Labels                map[string]string `json:"labels"`
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -103,8 +166,79 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"organisation_id": schema.StringAttribute{
-				MarkdownDescription: "Organisation ID that owns this project",
+				MarkdownDescription: "Organisation ID that owns this project. Defaults to the provider `organisation_id`, or the default organisation of the API key if neither is set. " +
+					"Changing it transfers the project to the new organisation, which requires `allow_transfer`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_transfer": schema.BoolAttribute{
+				MarkdownDescription: "Confirms that the project may be transferred to another organisation when `organisation_id` changes. Without it such a change, or an `organisation_id` that is only known during apply, is rejected at plan time.",
+				Optional:            true,
+			},
+			"billing_account_id": schema.StringAttribute{
+				MarkdownDescription: "Billing account (cost centre) to which the project is billed. Can be changed without replacing the project. " +
+					"Defaults to the default billing account of the organisation. Removing the argument keeps the current billing account.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal_investigator": schema.StringAttribute{
+				MarkdownDescription: "E-mail address of the principal investigator responsible for the project",
+				Optional:            true,
+			},
+			"technical_contact": schema.StringAttribute{
+				MarkdownDescription: "E-mail address of the technical contact of the project",
+				Optional:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Planned end of the project as an ISO 8601 date, e.g. `2026-12-31`, recorded for IT governance. Must be in the future when set or changed. Unlike `expires_at` it does not cause plan warnings. The API stores the date but does not act on it.",
+				Optional:            true,
+			},
+			"purpose": schema.StringAttribute{
+				MarkdownDescription: "Purpose of the project, e.g. the research question or the service it hosts",
+				Optional:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the project expires and should be archived, as an RFC 3339 timestamp. Plans show a warning once the project is within the provider `expiry_warning_days` of expiring. Unlike the informational `end_date`, this is the deadline for archiving the project. The API does not archive the project by itself once it has expired.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels of the project, e.g. department, grant number or data classification. Merged with the provider `default_labels`, labels set here take precedence.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"effective_labels": schema.MapAttribute{
+				MarkdownDescription: "All labels of the project, including the provider `default_labels`",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"openstack_projects": schema.MapNestedAttribute{
+				MarkdownDescription: "OpenStack projects backing this project, keyed by region",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "OpenStack project ID in the region",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "OpenStack domain of the project",
+							Computed:            true,
+						},
+						"auth_url": schema.StringAttribute{
+							MarkdownDescription: "Keystone authentication URL of the region",
+							Computed:            true,
+						},
+					},
+				},
 			},
 			"archived": schema.BoolAttribute{
 				MarkdownDescription: "Whether the project is archived",
@@ -126,6 +260,54 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contacts := map[string]types.String{
+		"principal_investigator": config.PrincipalInvestigator,
+		"technical_contact":      config.TechnicalContact,
+	}
+	for name, contact := range contacts {
+		if contact.IsNull() || contact.IsUnknown() {
+			continue
+		}
+		if !emailPattern.MatchString(contact.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Configuration Error",
+				fmt.Sprintf("'%s' must be a valid e-mail address. Got: %s", name, contact.ValueString()),
+			)
+		}
+	}
+
+	if !config.EndDate.IsNull() && !config.EndDate.IsUnknown() {
+		if _, err := time.Parse(isoDateLayout, config.EndDate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Configuration Error",
+				fmt.Sprintf("'end_date' must be an ISO 8601 date such as 2026-12-31. Got: %s", config.EndDate.ValueString()),
+			)
+		}
+	}
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Configuration Error",
+				fmt.Sprintf("'expires_at' must be an RFC 3339 timestamp such as 2025-12-31T23:59:59Z. Got: %s", config.ExpiresAt.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -159,8 +341,121 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
+	// The default organisation and labels are optional
+	organisationId, _ := providerData["organisation_id"].(string)
+	defaultLabels, _ := providerData["default_labels"].(map[string]string)
+	expiryWarningDays, _ := providerData["expiry_warning_days"].(int64)
+
 	r.client = client
 	r.endpoint = endpoint
+	r.organisationId = organisationId
+	r.defaultLabels = defaultLabels
+	r.expiryWarningDays = expiryWarningDays
+}
+
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the project is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var labels types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+
+	var endDate, priorEndDate types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("end_date"), &endDate)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("end_date"), &priorEndDate)...)
+	}
+
+	var organisationId, priorOrganisationId types.String
+	var allowTransfer types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organisation_id"), &organisationId)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_transfer"), &allowTransfer)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("organisation_id"), &priorOrganisationId)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Moving a project to another organisation has to be confirmed explicitly,
+	// so that an accidental edit cannot silently transfer it. An organisation that
+	// is only known during apply may be a transfer as well.
+	if !req.State.Raw.IsNull() && organisationId.IsUnknown() && !allowTransfer.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organisation_id"),
+			"Project Transfer Not Confirmed",
+			fmt.Sprintf("'organisation_id' is only known during apply and may transfer the project from organisation %s to another organisation. Set 'allow_transfer = true' to confirm the transfer.",
+				priorOrganisationId.ValueString()),
+		)
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !organisationId.Equal(priorOrganisationId) && !allowTransfer.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("organisation_id"),
+			"Project Transfer Not Confirmed",
+			fmt.Sprintf("Changing 'organisation_id' transfers the project from organisation %s to %s. Set 'allow_transfer = true' to confirm the transfer.",
+				priorOrganisationId.ValueString(), organisationId.ValueString()),
+		)
+		return
+	}
+
+	// Only a new end date has to be in the future, so that existing projects
+	// can still be planned once their end date has passed
+	if !endDate.IsNull() && !endDate.IsUnknown() && !endDate.Equal(priorEndDate) {
+		date, err := time.Parse(isoDateLayout, endDate.ValueString())
+		if err == nil && !date.After(time.Now()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_date"),
+				"Configuration Error",
+				fmt.Sprintf("'end_date' must be in the future. Got: %s", endDate.ValueString()),
+			)
+			return
+		}
+	}
+
+	// Show the labels merged with the provider defaults in the plan
+	effectiveLabels, diags := mergeLabels(ctx, r.defaultLabels, labels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_labels"), effectiveLabels)...)
+
+	var name, expiresAt types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	// Remind about projects that are expired or about to expire, as they are easily forgotten
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	remaining := time.Until(expiry)
+	switch {
+	case remaining <= 0:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Project Expired",
+			fmt.Sprintf("Project %q expired at %s. Archive the project or extend 'expires_at'.", name.ValueString(), expiresAt.ValueString()),
+		)
+	case remaining <= time.Duration(r.expiryWarningDays)*24*time.Hour:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Project Expires Soon",
+			fmt.Sprintf("Project %q expires in %d days at %s. Archive the project or extend 'expires_at'.", name.ValueString(), int64(remaining.Hours()/24), expiresAt.ValueString()),
+		)
+	}
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -182,6 +477,29 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		createRequest.Description = data.Description.ValueString()
 	}
 
+	// The resource organisation overrides the provider default
+	if !data.OrganisationId.IsUnknown() && !data.OrganisationId.IsNull() {
+		createRequest.OrganisationId = data.OrganisationId.ValueString()
+	} else {
+		createRequest.OrganisationId = r.organisationId
+	}
+
+	if !data.BillingAccountId.IsUnknown() && !data.BillingAccountId.IsNull() {
+		createRequest.BillingAccountId = data.BillingAccountId.ValueString()
+	}
+
+	createRequest.PrincipalInvestigator = data.PrincipalInvestigator.ValueString()
+	createRequest.TechnicalContact = data.TechnicalContact.ValueString()
+	createRequest.EndDate = data.EndDate.ValueString()
+	createRequest.Purpose = data.Purpose.ValueString()
+	createRequest.ExpiresAt = data.ExpiresAt.ValueString()
+
+	resp.Diagnostics.Append(data.EffectiveLabels.ElementsAs(ctx, &createRequest.Labels, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Marshal request body
 	jsonBody, err := json.Marshal(createRequest)
 	if err != nil {
@@ -202,7 +520,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	// Make API call
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create project, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -210,32 +528,29 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusCreated {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
 	// Parse response
 	var project Project
 	if err := json.Unmarshal(body, &project); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse response, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
 		return
 	}
 
 	// Update model with response data
-	data.Id = types.StringValue(project.Id)
-	data.Name = types.StringValue(project.Name)
-	data.Description = types.StringPointerValue(project.Description)
-	data.OrganisationId = types.StringValue(project.OrganisationId)
-	data.Archived = types.BoolValue(project.Archived)
-	data.ArchivedAt = types.StringValue(project.ArchivedAt)
-	data.CreatedAt = types.StringValue(project.CreatedAt)
-	data.UpdatedAt = types.StringValue(project.UpdatedAt)
+	resp.Diagnostics.Append(data.update(ctx, project)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a project resource")
@@ -266,7 +581,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Make API call
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read project, got error: %s", err)))
 		return
 	}
 	defer httpResp.Body.Close()
@@ -274,7 +589,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Read response body
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read response body, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
 		return
 	}
 
@@ -286,33 +601,139 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Check response status
 	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body)))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
 		return
 	}
 
 	// Parse response
 	var project Project
 	if err := json.Unmarshal(body, &project); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse response, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
 		return
 	}
 
 	// Update model with response data
-	data.Id = types.StringValue(project.Id)
-	data.Name = types.StringValue(project.Name)
-	data.Description = types.StringPointerValue(project.Description)
-	data.OrganisationId = types.StringValue(project.OrganisationId)
-	data.Archived = types.BoolValue(project.Archived)
-	data.ArchivedAt = types.StringValue(project.ArchivedAt)
-	data.CreatedAt = types.StringValue(project.CreatedAt)
-	data.UpdatedAt = types.StringValue(project.UpdatedAt)
+	resp.Diagnostics.Append(data.update(ctx, project)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("API Error", "Unable to update project, method is not implemented on server")
+	var data, state ProjectResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The organisation is changed through a separate transfer, which has to be confirmed
+	if !data.OrganisationId.Equal(state.OrganisationId) {
+		if !data.AllowTransfer.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organisation_id"),
+				"Project Transfer Not Confirmed",
+				fmt.Sprintf("Changing 'organisation_id' transfers the project from organisation %s to %s. Set 'allow_transfer = true' to confirm the transfer.",
+					state.OrganisationId.ValueString(), data.OrganisationId.ValueString()),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(r.transfer(ctx, data.Id.ValueString(), data.OrganisationId.ValueString())...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Keep the new organisation in state even if the following update fails
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organisation_id"), data.OrganisationId)...)
+	}
+
+	// Create API request body
+	updateRequest := ProjectUpdateRequest{
+		Name:                  data.Name.ValueString(),
+		BillingAccountId:      data.BillingAccountId.ValueStringPointer(),
+		PrincipalInvestigator: data.PrincipalInvestigator.ValueStringPointer(),
+		TechnicalContact:      data.TechnicalContact.ValueStringPointer(),
+		EndDate:               data.EndDate.ValueStringPointer(),
+		Purpose:               data.Purpose.ValueStringPointer(),
+		ExpiresAt:             data.ExpiresAt.ValueStringPointer(),
+		Labels:                map[string]string{},
+	}
+
+	if !data.Description.IsNull() {
+		updateRequest.Description = data.Description.ValueString()
+	}
+
+	resp.Diagnostics.Append(data.EffectiveLabels.ElementsAs(ctx, &updateRequest.Labels, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal update request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+data.Id.ValueString(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to update project, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var project Project
+	if err := json.Unmarshal(body, &project); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, project)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a project resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -324,3 +745,147 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// transfer moves a project to another organisation.
+func (r *ProjectResource) transfer(ctx context.Context, projectId string, organisationId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(ProjectTransferRequest{OrganisationId: organisationId})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to marshal transfer request, got error: %s", err))
+		return diags
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/projects/"+projectId+"/transfer", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return diags
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to transfer project, got error: %s", err)))
+		return diags
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return diags
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		diags.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return diags
+	}
+
+	tflog.Info(ctx, "transferred project to another organisation", map[string]interface{}{
+		"project_id":      projectId,
+		"organisation_id": organisationId,
+	})
+
+	return diags
+}
+
+// update sets the model from the API response. The API returns the labels merged
+// with the provider defaults, so only the configured labels are kept in `labels`.
+func (m *ProjectResourceModel) update(ctx context.Context, project Project) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	openstackProjects, d := openstackProjectsValue(project.OpenstackProjects)
+	diags.Append(d...)
+
+	// A project without labels has an empty map of effective labels, as planned
+	effectiveLabels := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	if len(project.Labels) > 0 {
+		effectiveLabels, d = types.MapValueFrom(ctx, types.StringType, project.Labels)
+		diags.Append(d...)
+	}
+
+	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
+		labels := make(map[string]string)
+		for key := range m.Labels.Elements() {
+			if value, ok := project.Labels[key]; ok {
+				labels[key] = value
+			}
+		}
+		m.Labels, d = types.MapValueFrom(ctx, types.StringType, labels)
+		diags.Append(d...)
+	}
+
+	m.Id = types.StringValue(project.Id)
+	m.Name = types.StringValue(project.Name)
+	m.Description = types.StringPointerValue(project.Description)
+	m.OrganisationId = types.StringValue(project.OrganisationId)
+	m.BillingAccountId = types.StringPointerValue(project.BillingAccountId)
+	m.PrincipalInvestigator = types.StringPointerValue(project.PrincipalInvestigator)
+	m.TechnicalContact = types.StringPointerValue(project.TechnicalContact)
+	m.EndDate = types.StringPointerValue(project.EndDate)
+	m.Purpose = types.StringPointerValue(project.Purpose)
+	m.ExpiresAt = types.StringPointerValue(project.ExpiresAt)
+	m.EffectiveLabels = effectiveLabels
+	m.OpenstackProjects = openstackProjects
+	m.Archived = types.BoolValue(project.Archived)
+	m.ArchivedAt = types.StringValue(project.ArchivedAt)
+	m.CreatedAt = types.StringValue(project.CreatedAt)
+	m.UpdatedAt = types.StringValue(project.UpdatedAt)
+
+	return diags
+}
+
+// mergeLabels merges the configured labels into the provider default labels. The
+// result is unknown as long as any of the configured labels is unknown.
+func mergeLabels(ctx context.Context, defaultLabels map[string]string, labels types.Map) (types.Map, diag.Diagnostics) {
+	if labels.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	for _, value := range labels.Elements() {
+		if value.IsUnknown() {
+			return types.MapUnknown(types.StringType), nil
+		}
+	}
+
+	merged := make(map[string]string)
+	maps.Copy(merged, defaultLabels)
+
+	var configured map[string]string
+	diags := labels.ElementsAs(ctx, &configured, false)
+	maps.Copy(merged, configured)
+
+	value, d := types.MapValueFrom(ctx, types.StringType, merged)
+	diags.Append(d...)
+
+	return value, diags
+}
+
+// openstackProjectsValue converts the OpenStack projects of a project into a Terraform map value.
+func openstackProjectsValue(openstackProjects map[string]OpenstackProject) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType := types.ObjectType{AttrTypes: openstackProjectAttrTypes}
+	elements := make(map[string]attr.Value, len(openstackProjects))
+	for region, openstackProject := range openstackProjects {
+		element, d := types.ObjectValue(openstackProjectAttrTypes, map[string]attr.Value{
+			"project_id": types.StringValue(openstackProject.ProjectId),
+			"domain":     types.StringValue(openstackProject.Domain),
+			"auth_url":   types.StringValue(openstackProject.AuthUrl),
+		})
+		diags.Append(d...)
+		elements[region] = element
+	}
+
+	value, d := types.MapValue(elementType, elements)
+	diags.Append(d...)
+
+	return value, diags
+}
@@ -4,10 +4,14 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -33,6 +37,11 @@ func TestAccProjectResource(t *testing.T) {
 						tfjsonpath.New("name"),
 						knownvalue.StringExact("Test Project"),
 					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("openstack_projects").AtMapKey("ZH").AtMapKey("auth_url"),
+						knownvalue.StringExact("https://zh.cloud.switch.ch:5000/v3"),
+					),
 				},
 			},
 			{
@@ -64,6 +73,394 @@ func TestAccProjectResource(t *testing.T) {
 	})
 }
 
+func TestAccProjectResourceOrganisation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceOrganisationConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("organisation_id"),
+						knownvalue.StringExact("5b3c5a8e-2f0d-4f7e-9f0a-1c2d3e4f5a6b"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceBillingAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The API assigns the default billing account of the organisation
+			{
+				Config: testAccProjectResourceDefaultBillingAccountConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("billing_account_id"),
+						knownvalue.StringExact("9d0c6f1e-8a43-4d38-9f5e-2b7c1e0a4f11"),
+					),
+				},
+			},
+			{
+				Config: testAccProjectResourceBillingAccountConfig("8765-4321"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("billing_account_id"),
+						knownvalue.StringExact("3f6e2a7b-1c94-4b0d-8e2f-7a5d9c3b1e22"),
+					),
+				},
+			},
+			// Removing the argument keeps the current billing account
+			{
+				Config: testAccProjectResourceDefaultBillingAccountConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccProjectResourceBillingAccountConfig("1234-5678"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("billing_account_id"),
+						knownvalue.StringExact("9d0c6f1e-8a43-4d38-9f5e-2b7c1e0a4f11"),
+					),
+				},
+			},
+			{
+				Config: testAccProjectResourceBillingAccountConfig("8765-4321"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("billing_account_id"),
+						knownvalue.StringExact("3f6e2a7b-1c94-4b0d-8e2f-7a5d9c3b1e22"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResourceLabels(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceLabelsConfig("Informatikdienste", `{
+    grant          = "SNF-200021"
+    classification = "confidential"
+  }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(
+							"switchcloud_project.test",
+							tfjsonpath.New("effective_labels"),
+							knownvalue.MapExact(map[string]knownvalue.Check{
+								"department":     knownvalue.StringExact("Informatikdienste"),
+								"grant":          knownvalue.StringExact("SNF-200021"),
+								"classification": knownvalue.StringExact("confidential"),
+							}),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("labels"),
+						knownvalue.MapSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("effective_labels"),
+						knownvalue.MapSizeExact(3),
+					),
+				},
+			},
+			{
+				ResourceName:            "switchcloud_project.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels"},
+			},
+			{
+				Config: testAccProjectResourceLabelsConfig("Digitale Dienste", "null"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_project.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"switchcloud_project.test",
+							tfjsonpath.New("effective_labels"),
+							knownvalue.MapExact(map[string]knownvalue.Check{
+								"department":     knownvalue.StringExact("Digitale Dienste"),
+								"classification": knownvalue.StringExact("internal"),
+							}),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("labels"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceLabelsConfig(department string, labels string) string {
+	return fmt.Sprintf(`
+provider "switchcloud" {
+  default_labels = {
+    department     = %[1]q
+    classification = "internal"
+  }
+}
+
+resource "switchcloud_project" "test" {
+  name   = "Test Project"
+  labels = %[2]s
+}
+`, department, labels)
+}
+
+func TestAccProjectResourceMetadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectResourceMetadataConfig("it-support", "2099-12-31"),
+				ExpectError: regexp.MustCompile("'technical_contact' must be a valid e-mail address"),
+			},
+			{
+				Config:      testAccProjectResourceMetadataConfig("it-support@example.com", "31.12.2099"),
+				ExpectError: regexp.MustCompile("'end_date' must be an ISO 8601 date"),
+			},
+			{
+				Config:      testAccProjectResourceMetadataConfig("it-support@example.com", "2020-01-31"),
+				ExpectError: regexp.MustCompile("'end_date' must be in the future"),
+			},
+			{
+				Config: testAccProjectResourceMetadataConfig("it-support@example.com", "2099-12-31"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("principal_investigator"),
+						knownvalue.StringExact("pi@example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("technical_contact"),
+						knownvalue.StringExact("it-support@example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("end_date"),
+						knownvalue.StringExact("2099-12-31"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("purpose"),
+						knownvalue.StringExact("Genome sequencing pipeline"),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectResourceMetadataConfig("research-it@example.com", "2100-06-30"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("technical_contact"),
+						knownvalue.StringExact("research-it@example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("end_date"),
+						knownvalue.StringExact("2100-06-30"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceMetadataConfig(technicalContact string, endDate string) string {
+	return fmt.Sprintf(`
+resource "switchcloud_project" "test" {
+  name                   = "Test Project"
+  principal_investigator = "pi@example.com"
+  technical_contact      = %[1]q
+  end_date               = %[2]q
+  purpose                = "Genome sequencing pipeline"
+}
+`, technicalContact, endDate)
+}
+
+func TestAccProjectResourceExpiry(t *testing.T) {
+	expiresSoon := time.Now().AddDate(0, 0, 10).UTC().Format(time.RFC3339)
+	expired := time.Now().AddDate(0, 0, -1).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectResourceExpiryConfig(14, "2099-12-31"),
+				ExpectError: regexp.MustCompile("'expires_at' must be an RFC 3339 timestamp"),
+			},
+			{
+				Config:      testAccProjectResourceExpiryConfig(-1, expiresSoon),
+				ExpectError: regexp.MustCompile("'expiry_warning_days' must not be negative"),
+			},
+			// Expiring projects only cause a warning
+			{
+				Config: testAccProjectResourceExpiryConfig(14, expiresSoon),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("expires_at"),
+						knownvalue.StringExact(expiresSoon),
+					),
+				},
+			},
+			{
+				Config: testAccProjectResourceExpiryConfig(14, expired),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("expires_at"),
+						knownvalue.StringExact(expired),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceExpiryConfig(expiryWarningDays int, expiresAt string) string {
+	return fmt.Sprintf(`
+provider "switchcloud" {
+  expiry_warning_days = %[1]d
+}
+
+resource "switchcloud_project" "test" {
+  name       = "Test Project"
+  expires_at = %[2]q
+}
+`, expiryWarningDays, expiresAt)
+}
+
+func TestAccProjectResourceTransfer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceTransferConfig("5b3c5a8e-2f0d-4f7e-9f0a-1c2d3e4f5a6b", false),
+			},
+			{
+				Config:      testAccProjectResourceTransferConfig("7e1f3a9c-4b6d-4c2e-8a5f-0d9b2c7e4f13", false),
+				ExpectError: regexp.MustCompile("Set 'allow_transfer = true' to confirm the transfer"),
+			},
+			// An organisation that is only known during apply has to be confirmed as well
+			{
+				Config:      testAccProjectResourceUnknownTransferConfig("7e1f3a9c-4b6d-4c2e-8a5f-0d9b2c7e4f13"),
+				ExpectError: regexp.MustCompile("'organisation_id' is only known during apply"),
+			},
+			{
+				Config: testAccProjectResourceTransferConfig("7e1f3a9c-4b6d-4c2e-8a5f-0d9b2c7e4f13", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_project.test",
+						tfjsonpath.New("organisation_id"),
+						knownvalue.StringExact("7e1f3a9c-4b6d-4c2e-8a5f-0d9b2c7e4f13"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceTransferConfig(organisationId string, allowTransfer bool) string {
+	return fmt.Sprintf(`
+resource "switchcloud_project" "test" {
+  name            = "Test Project"
+  organisation_id = %[1]q
+  allow_transfer  = %[2]t
+}
+`, organisationId, allowTransfer)
+}
+
+func testAccProjectResourceUnknownTransferConfig(organisationId string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "organisation" {
+  input = %[1]q
+}
+
+resource "switchcloud_project" "test" {
+  name            = "Test Project"
+  organisation_id = terraform_data.organisation.output
+}
+`, organisationId)
+}
+
+const testAccProjectResourceDefaultBillingAccountConfig = `
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+`
+
+func testAccProjectResourceBillingAccountConfig(costCentre string) string {
+	return fmt.Sprintf(`
+data "switchcloud_billing_account" "test" {
+  cost_centre = %[1]q
+}
+
+resource "switchcloud_project" "test" {
+  name               = "Test Project"
+  billing_account_id = data.switchcloud_billing_account.test.id
+}
+`, costCentre)
+}
+
 const testAccProjectResourceConfig = `
 resource "switchcloud_project" "test" {
   name = "Test Project"
@@ -76,3 +473,10 @@ resource "switchcloud_project" "test" {
   description = "This is a test project description."
 }
 `
+
+const testAccProjectResourceOrganisationConfig = `
+resource "switchcloud_project" "test" {
+  name            = "Test Project"
+  organisation_id = "5b3c5a8e-2f0d-4f7e-9f0a-1c2d3e4f5a6b"
+}
+`
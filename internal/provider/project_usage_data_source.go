@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectUsageDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ProjectUsageDataSource{}
+
+func NewProjectUsageDataSource() datasource.DataSource {
+	return &ProjectUsageDataSource{}
+}
+
+// ProjectUsageDataSource defines the data source implementation.
+type ProjectUsageDataSource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// ProjectUsageDataSourceModel describes the data source data model.
+type ProjectUsageDataSourceModel struct {
+	ProjectId types.String            `tfsdk:"project_id"`
+	StartDate types.String            `tfsdk:"start_date"`
+	EndDate   types.String            `tfsdk:"end_date"`
+	TotalCost types.Float64           `tfsdk:"total_cost"`
+	Currency  types.String            `tfsdk:"currency"`
+	Usage     []ProjectUsageItemModel `tfsdk:"usage"`
+}
+
+// ProjectUsageItemModel describes the cost and usage of a service in a region.
+type ProjectUsageItemModel struct {
+	Service  types.String  `tfsdk:"service"`
+	Region   types.String  `tfsdk:"region"`
+	Quantity types.Float64 `tfsdk:"quantity"`
+	Unit     types.String  `tfsdk:"unit"`
+	Cost     types.Float64 `tfsdk:"cost"`
+}
+
+// ProjectUsage represents the API response structure.
+type ProjectUsage struct {
+	ProjectId string             `json:"project_id"`
+	StartDate string             `json:"start_date"`
+	EndDate   string             `json:"end_date"`
+	TotalCost float64            `json:"total_cost"`
+	Currency  string             `json:"currency"`
+	Usage     []ProjectUsageItem `json:"usage"`
+}
+
+// ProjectUsageItem represents the cost and usage of a service in a region.
+type ProjectUsageItem struct {
+	Service  string  `json:"service"`
+	Region   string  `json:"region"`
+	Quantity float64 `json:"quantity"`
+	Unit     string  `json:"unit"`
+	Cost     float64 `json:"cost"`
+}
+
+func (d *ProjectUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_usage"
+}
+
+func (d *ProjectUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Accumulated cost and resource usage of a project for a date range",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier",
+				Required:            true,
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "First day of the date range (inclusive), in ISO 8601 format, e.g. `2025-01-01`",
+				Required:            true,
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Last day of the date range (inclusive), in ISO 8601 format, e.g. `2025-12-31`",
+				Required:            true,
+			},
+			"total_cost": schema.Float64Attribute{
+				MarkdownDescription: "Accumulated cost of the project in the date range",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "ISO 4217 currency code of the costs",
+				Computed:            true,
+			},
+			"usage": schema.ListNestedAttribute{
+				MarkdownDescription: "Cost and usage broken down by service and region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							MarkdownDescription: "Service, e.g. `compute`, `volume` or `object_storage`",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region in which the usage occurred",
+							Computed:            true,
+						},
+						"quantity": schema.Float64Attribute{
+							MarkdownDescription: "Accumulated usage in `unit`",
+							Computed:            true,
+						},
+						"unit": schema.StringAttribute{
+							MarkdownDescription: "Unit of the usage, e.g. `core_hours` or `gb_hours`",
+							Computed:            true,
+						},
+						"cost": schema.Float64Attribute{
+							MarkdownDescription: "Accumulated cost of the usage",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectUsageDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config ProjectUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.StartDate.IsUnknown() || config.EndDate.IsUnknown() {
+		return
+	}
+
+	startDate, err := time.Parse(isoDateLayout, config.StartDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_date"),
+			"Configuration Error",
+			fmt.Sprintf("'start_date' must be an ISO 8601 date such as 2025-01-01. Got: %s", config.StartDate.ValueString()),
+		)
+	}
+
+	endDate, err := time.Parse(isoDateLayout, config.EndDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Configuration Error",
+			fmt.Sprintf("'end_date' must be an ISO 8601 date such as 2025-12-31. Got: %s", config.EndDate.ValueString()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if endDate.Before(startDate) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Configuration Error",
+			"'end_date' must not be before 'start_date'.",
+		)
+	}
+}
+
+func (d *ProjectUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	d.client = client
+	d.endpoint = endpoint
+}
+
+func (d *ProjectUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("start_date", data.StartDate.ValueString())
+	query.Set("end_date", data.EndDate.ValueString())
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(d.endpoint, "/")+"/api/v1/projects/"+data.ProjectId.ValueString()+"/usage?"+query.Encode(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read project usage, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var projectUsage ProjectUsage
+	if err := json.Unmarshal(body, &projectUsage); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.TotalCost = types.Float64Value(projectUsage.TotalCost)
+	data.Currency = types.StringValue(projectUsage.Currency)
+	data.Usage = make([]ProjectUsageItemModel, 0, len(projectUsage.Usage))
+	for _, item := range projectUsage.Usage {
+		data.Usage = append(data.Usage, ProjectUsageItemModel{
+			Service:  types.StringValue(item.Service),
+			Region:   types.StringValue(item.Region),
+			Quantity: types.Float64Value(item.Quantity),
+			Unit:     types.StringValue(item.Unit),
+			Cost:     types.Float64Value(item.Cost),
+		})
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a project usage data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
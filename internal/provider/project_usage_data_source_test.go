@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectUsageDataSourceInvalidRangeConfig,
+				ExpectError: regexp.MustCompile("'end_date' must not be before 'start_date'"),
+			},
+			// Read testing
+			{
+				Config: testAccProjectUsageDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project_usage.test",
+						tfjsonpath.New("currency"),
+						knownvalue.StringExact("CHF"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project_usage.test",
+						tfjsonpath.New("total_cost"),
+						knownvalue.Float64Exact(58.5),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project_usage.test",
+						tfjsonpath.New("usage").AtSliceIndex(0).AtMapKey("service"),
+						knownvalue.StringExact("compute"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_project_usage.test",
+						tfjsonpath.New("usage"),
+						knownvalue.ListSizeExact(3),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccProjectUsageDataSourceInvalidRangeConfig = `
+data "switchcloud_project_usage" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  start_date = "2025-01-31"
+  end_date   = "2025-01-01"
+}
+`
+
+const testAccProjectUsageDataSourceConfig = `
+data "switchcloud_project_usage" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  start_date = "2025-01-01"
+  end_date   = "2025-01-30"
+}
+`
@@ -5,14 +5,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure SwitchcloudProvider satisfies various provider interfaces.
@@ -28,8 +31,22 @@ type SwitchcloudProvider struct {
 
 // SwitchcloudProviderModel describes the provider data model.
 type SwitchcloudProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ApiKey   types.String `tfsdk:"api_key"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	ProxyUsername      types.String `tfsdk:"proxy_username"`
+	ProxyPassword      types.String `tfsdk:"proxy_password"`
+	NoProxy            types.List   `tfsdk:"no_proxy"`
+	UserAgentSuffix    types.String `tfsdk:"user_agent_suffix"`
+	LogRedactFields    types.List   `tfsdk:"log_redact_fields"`
+	OrganisationId     types.String `tfsdk:"organisation_id"`
+	DefaultLabels      types.Map    `tfsdk:"default_labels"`
+	ExpiryWarningDays  types.Int64  `tfsdk:"expiry_warning_days"`
 }
 
 func (p *SwitchcloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,6 +66,67 @@ func (p *SwitchcloudProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"organisation_id": schema.StringAttribute{
+				MarkdownDescription: "Default organisation ID in which projects are created, for accounts that belong to several organisations. Can also be set via `SWITCHCLOUD_ORGANISATION_ID`.",
+				Optional:            true,
+			},
+			"default_labels": schema.MapAttribute{
+				MarkdownDescription: "Labels added to every project managed by the provider, e.g. the department or cost centre. Labels set on a project take precedence.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days before a project's `expires_at` from which plans show a warning. Defaults to `30`, `0` only warns about expired projects.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle used to verify the SwitchCloud API certificate, in addition to the system roots. Can also be set via `SWITCHCLOUD_CA_CERT_FILE`.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA bundle used to verify the SwitchCloud API certificate, in addition to the system roots. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate for mutual TLS. Requires `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of the client certificate for mutual TLS. Requires `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the SwitchCloud API certificate. Only use this against test instances.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy used to reach the SwitchCloud API, e.g. `http://proxy.example.com:3128`. Overrides the `HTTP_PROXY`/`HTTPS_PROXY` environment variables. Can also be set via `SWITCHCLOUD_PROXY_URL`.",
+				Optional:            true,
+			},
+			"proxy_username": schema.StringAttribute{
+				MarkdownDescription: "Username used to authenticate against the proxy. Requires `proxy_url`.",
+				Optional:            true,
+			},
+			"proxy_password": schema.StringAttribute{
+				MarkdownDescription: "Password used to authenticate against the proxy. Requires `proxy_url`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"no_proxy": schema.ListAttribute{
+				MarkdownDescription: "Hosts, domains (e.g. `.example.com`) or CIDR ranges that are reached without the proxy. Requires `proxy_url`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the `User-Agent` header of every request, e.g. to identify the team or pipeline running Terraform.",
+				Optional:            true,
+			},
+			"log_redact_fields": schema.ListAttribute{
+				MarkdownDescription: "Additional JSON field names whose values are masked in HTTP debug logs. `Authorization` headers, API keys, passwords, secrets and tokens are always masked.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -72,27 +150,121 @@ func (p *SwitchcloudProvider) Configure(ctx context.Context, req provider.Config
 		endpoint = os.Getenv("SWITCHCLOUD_ENDPOINT")
 	}
 
+	if os.Getenv("SWITCHCLOUD_CA_CERT_FILE") != "" {
+		data.CaCertFile = types.StringValue(os.Getenv("SWITCHCLOUD_CA_CERT_FILE"))
+	}
+
+	tlsConfig, diags := buildTLSConfig(data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tlsConfig.InsecureSkipVerify {
+		tflog.Warn(ctx, "TLS certificate verification of the SwitchCloud API is disabled")
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Insecure TLS Configuration",
+			"TLS certificate verification is disabled. The provider will accept any certificate presented by "+endpoint+
+				", which exposes the API key and all requests to interception. Do not use this setting outside of test instances.",
+		)
+	}
+
+	if os.Getenv("SWITCHCLOUD_PROXY_URL") != "" {
+		data.ProxyUrl = types.StringValue(os.Getenv("SWITCHCLOUD_PROXY_URL"))
+	}
+
+	proxy, diags := buildProxyFunc(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport, err := newTransport(tlsConfig, proxy)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create HTTP Transport", err.Error())
+		return
+	}
+
+	redactedFields := defaultRedactedFields
+	if !data.LogRedactFields.IsNull() {
+		var logRedactFields []string
+		resp.Diagnostics.Append(data.LogRedactFields.ElementsAs(ctx, &logRedactFields, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		redactedFields = append(logRedactFields, defaultRedactedFields...)
+	}
+
+	// Log requests and responses with sensitive values masked
+	loggingTransport := &loggingTransport{
+		redactedFields: redactedFields,
+		logBodies:      httpBodyLoggingEnabled(),
+		transport:      transport,
+	}
+
+	// Identify the provider and tag every request with a request ID
+	userAgentTransport := &userAgentTransport{
+		userAgent: userAgent(p.version, req.TerraformVersion, data.UserAgentSuffix.ValueString()),
+		transport: loggingTransport,
+	}
+
 	// Create HTTP client with authentication if API key is provided
-	client := http.DefaultClient
+	client := &http.Client{
+		Transport: userAgentTransport,
+	}
 
 	if os.Getenv("SWITCHCLOUD_API_KEY") != "" {
 		data.ApiKey = types.StringValue(os.Getenv("SWITCHCLOUD_API_KEY"))
 	}
 	if !data.ApiKey.IsNull() {
-		// You can customize the HTTP client here to add authentication headers
-		// For example, you might create a custom RoundTripper
+		// Add the API key on top of the transport chain, the logging transport masks the Authorization header
 		client = &http.Client{
 			Transport: &authenticatedTransport{
 				apiKey:    data.ApiKey.ValueString(),
-				transport: http.DefaultTransport,
+				transport: userAgentTransport,
 			},
 		}
 	}
 
-	// Pass both client and endpoint to resources and data sources
+	if os.Getenv("SWITCHCLOUD_ORGANISATION_ID") != "" {
+		data.OrganisationId = types.StringValue(os.Getenv("SWITCHCLOUD_ORGANISATION_ID"))
+	}
+
+	var defaultLabels map[string]string
+	if !data.DefaultLabels.IsNull() {
+		resp.Diagnostics.Append(data.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Default expiry warning period if not provided
+	expiryWarningDays := int64(30)
+	if !data.ExpiryWarningDays.IsNull() {
+		expiryWarningDays = data.ExpiryWarningDays.ValueInt64()
+	}
+
+	if expiryWarningDays < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiry_warning_days"),
+			"Invalid Expiry Warning Period",
+			fmt.Sprintf("'expiry_warning_days' must not be negative. Got: %d", expiryWarningDays),
+		)
+		return
+	}
+
+	// Pass client, endpoint and defaults to resources and data sources
 	providerData := map[string]interface{}{
-		"client":   client,
-		"endpoint": endpoint,
+		"client":              client,
+		"endpoint":            endpoint,
+		"organisation_id":     data.OrganisationId.ValueString(),
+		"default_labels":      defaultLabels,
+		"expiry_warning_days": expiryWarningDays,
 	}
 
 	resp.DataSourceData = providerData
@@ -115,12 +287,32 @@ func (p *SwitchcloudProvider) Resources(ctx context.Context) []func() resource.R
 	return []func() resource.Resource{
 		NewProjectResource,
 		NewProjectMemberResource,
+		NewProjectBudgetResource,
+		NewBudgetAlertResource,
+		NewProjectQuotaResource,
+		NewApplicationCredentialResource,
+		NewS3CredentialResource,
+		NewServiceAccountResource,
+		NewServiceAccountKeyResource,
+		NewGroupResource,
+		NewGroupMembershipResource,
+		NewOrganisationMemberResource,
+		NewWebhookResource,
+		NewSshKeyResource,
+		NewSupportRequestResource,
 	}
 }
 
 func (p *SwitchcloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewProjectDataSource,
+		NewProjectUsageDataSource,
+		NewBillingAccountDataSource,
+		NewRegionsDataSource,
+		NewOrganisationMembersDataSource,
+		NewWebhookDeliveriesDataSource,
+		NewAuditEventsDataSource,
+		NewSupportRequestDataSource,
 	}
 }
 
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRegionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRegionsDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_regions.test",
+						tfjsonpath.New("regions"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_regions.test",
+						tfjsonpath.New("regions").AtSliceIndex(0).AtMapKey("id"),
+						knownvalue.StringExact("ZH"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_regions.test",
+						tfjsonpath.New("regions").AtSliceIndex(0).AtMapKey("auth_url"),
+						knownvalue.StringExact("https://zh.cloud.switch.ch:5000/v3"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_regions.test",
+						tfjsonpath.New("regions").AtSliceIndex(1).AtMapKey("services"),
+						knownvalue.ListSizeExact(4),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccRegionsDataSourceConfig = `
+data "switchcloud_regions" "test" {}
+`
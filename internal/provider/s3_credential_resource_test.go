@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccS3CredentialResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3CredentialResourceConfig("2025-01"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_s3_credential.test",
+						tfjsonpath.New("access_key"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_s3_credential.test",
+						tfjsonpath.New("secret_key"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_s3_credential.test",
+						tfjsonpath.New("user_id"),
+						knownvalue.StringExact("4b7f2c9e-6a1d-4e83-b5f0-9c2d7e1a3f68"),
+					),
+				},
+			},
+			// Changing the rotation trigger creates a new key pair
+			{
+				Config: testAccS3CredentialResourceConfig("2025-02"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_s3_credential.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_s3_credential.test",
+						tfjsonpath.New("secret_key"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccS3CredentialResourceConfig(rotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "switchcloud_project" "test" {
+  name = "Test Project"
+}
+
+resource "switchcloud_s3_credential" "test" {
+  project_id       = switchcloud_project.test.id
+  rotation_trigger = %[1]q
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rotationTrigger)
+}
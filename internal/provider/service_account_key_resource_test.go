@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccServiceAccountKeyResource(t *testing.T) {
+	compareId := statecheck.CompareValue(compare.ValuesDiffer())
+	t.Cleanup(func() { now = time.Now })
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountKeyResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account_key.test",
+						tfjsonpath.New("token"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account_key.test",
+						tfjsonpath.New("scopes"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("projects:read"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account_key.test",
+						tfjsonpath.New("expires_at"),
+						knownvalue.NotNull(),
+					),
+					compareId.AddStateValue(
+						"switchcloud_service_account_key.test",
+						tfjsonpath.New("id"),
+					),
+				},
+			},
+			// Once expired the key is deleted and created again with a new expiry
+			{
+				PreConfig: func() {
+					now = func() time.Time { return time.Now().AddDate(0, 0, 366) }
+				},
+				Config: testAccServiceAccountKeyResourceConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_service_account_key.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					compareId.AddStateValue(
+						"switchcloud_service_account_key.test",
+						tfjsonpath.New("id"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account_key.test",
+						tfjsonpath.New("token"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccServiceAccountKeyResourceConfig = `
+resource "switchcloud_service_account" "test" {
+  name = "ci"
+}
+
+resource "switchcloud_service_account_key" "test" {
+  service_account_id = switchcloud_service_account.test.id
+  description        = "GitLab CI"
+  expires_in_days    = 365
+  scopes             = ["projects:read"]
+}
+`
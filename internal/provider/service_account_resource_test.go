@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccServiceAccountResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("organisation_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("description"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_service_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceAccountResourceUpdateConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("deploy"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("Deploys the research platform"),
+					),
+				},
+			},
+			// A disabled service account cannot get new keys
+			{
+				Config: testAccServiceAccountResourceDisabledConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_service_account.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				Config:      testAccServiceAccountResourceDisabledKeyConfig,
+				ExpectError: regexp.MustCompile("Service Account is disabled"),
+			},
+		},
+	})
+}
+
+const testAccServiceAccountResourceConfig = `
+resource "switchcloud_service_account" "test" {
+  name = "ci"
+}
+`
+
+const testAccServiceAccountResourceUpdateConfig = `
+resource "switchcloud_service_account" "test" {
+  name        = "deploy"
+  description = "Deploys the research platform"
+}
+`
+
+const testAccServiceAccountResourceDisabledConfig = `
+resource "switchcloud_service_account" "test" {
+  name        = "deploy"
+  description = "Deploys the research platform"
+  enabled     = false
+}
+`
+
+const testAccServiceAccountResourceDisabledKeyConfig = `
+resource "switchcloud_service_account" "test" {
+  name        = "deploy"
+  description = "Deploys the research platform"
+  enabled     = false
+}
+
+resource "switchcloud_service_account_key" "test" {
+  service_account_id = switchcloud_service_account.test.id
+}
+`
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SupportRequestDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &SupportRequestDataSource{}
+
+func NewSupportRequestDataSource() datasource.DataSource {
+	return &SupportRequestDataSource{}
+}
+
+// SupportRequestDataSource defines the data source implementation.
+type SupportRequestDataSource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// SupportRequestDataSourceModel describes the data source data model.
+type SupportRequestDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ReferenceNumber types.String `tfsdk:"reference_number"`
+	ProjectId       types.String `tfsdk:"project_id"`
+	Category        types.String `tfsdk:"category"`
+	Subject         types.String `tfsdk:"subject"`
+	Status          types.String `tfsdk:"status"`
+	Resolution      types.String `tfsdk:"resolution"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+func (d *SupportRequestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_support_request"
+}
+
+func (d *SupportRequestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Status of a support request in the Switchcloud platform, e.g. to make later steps depend on its approval",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Support request identifier",
+				Optional:            true,
+				Computed:            true,
+			},
+			"reference_number": schema.StringAttribute{
+				MarkdownDescription: "Reference number of the support request, e.g. `SR-2025-000123`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project ID the support request is about",
+				Computed:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category of the support request",
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the support request",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the support request, one of `open`, `in_progress`, `waiting_for_customer`, `approved`, `rejected` or `closed`",
+				Computed:            true,
+			},
+			"resolution": schema.StringAttribute{
+				MarkdownDescription: "Answer of the support once the support request is approved, rejected or closed",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the support request was opened",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the support request was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SupportRequestDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config SupportRequestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that either id or reference_number is provided
+	if config.Id.IsNull() && config.ReferenceNumber.IsNull() {
+		resp.Diagnostics.AddError(
+			"Configuration Error",
+			"Either 'id' or 'reference_number' must be provided for a support request.",
+		)
+	}
+
+	if !config.Id.IsNull() && !config.ReferenceNumber.IsNull() {
+		resp.Diagnostics.AddError(
+			"Configuration Error",
+			"Only one of 'id' or 'reference_number' can be provided for a support request.",
+		)
+	}
+}
+
+func (d *SupportRequestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	d.client = client
+	d.endpoint = endpoint
+}
+
+func (d *SupportRequestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SupportRequestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Support requests are looked up directly by ID, or by filtering on the reference number
+	requestUrl := strings.TrimSuffix(d.endpoint, "/") + "/api/v1/support-requests/" + data.Id.ValueString()
+	if data.Id.IsNull() {
+		query := url.Values{}
+		query.Set("reference_number", data.ReferenceNumber.ValueString())
+		requestUrl = strings.TrimSuffix(d.endpoint, "/") + "/api/v1/support-requests?" + query.Encode()
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", requestUrl, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read support request, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var supportRequest SupportRequest
+	if data.Id.IsNull() {
+		var supportRequests []SupportRequest
+		if err := json.Unmarshal(body, &supportRequests); err != nil {
+			resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+			return
+		}
+
+		if len(supportRequests) != 1 {
+			resp.Diagnostics.AddError(
+				"Support Request Not Found",
+				fmt.Sprintf("Expected exactly one support request with reference number %s, got: %d", data.ReferenceNumber.ValueString(), len(supportRequests)),
+			)
+			return
+		}
+		supportRequest = supportRequests[0]
+	} else if err := json.Unmarshal(body, &supportRequest); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.Id = types.StringValue(supportRequest.Id)
+	data.ReferenceNumber = types.StringValue(supportRequest.ReferenceNumber)
+	data.ProjectId = types.StringValue(supportRequest.ProjectId)
+	data.Category = types.StringValue(supportRequest.Category)
+	data.Subject = types.StringValue(supportRequest.Subject)
+	data.Status = types.StringValue(supportRequest.Status)
+	data.Resolution = types.StringPointerValue(supportRequest.Resolution)
+	data.CreatedAt = types.StringValue(supportRequest.CreatedAt)
+	data.UpdatedAt = types.StringValue(supportRequest.UpdatedAt)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a support request data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSupportRequestDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSupportRequestDataSourceMissingConfig,
+				ExpectError: regexp.MustCompile("Either 'id' or 'reference_number' must be provided"),
+			},
+			{
+				Config: testAccSupportRequestDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_support_request.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("6f1d2c3b-4a5e-4f6d-8c7b-9a0e1d2c3b4a"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_support_request.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("approved"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_support_request.test",
+						tfjsonpath.New("resolution"),
+						knownvalue.StringExact("GPU flavours are available in region ZH."),
+					),
+				},
+			},
+			{
+				Config: testAccSupportRequestDataSourceIdConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_support_request.test",
+						tfjsonpath.New("reference_number"),
+						knownvalue.StringExact("SR-2024-000042"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_support_request.test",
+						tfjsonpath.New("category"),
+						knownvalue.StringExact("gpu_access"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccSupportRequestDataSourceMissingConfig = `
+data "switchcloud_support_request" "test" {
+}
+`
+
+const testAccSupportRequestDataSourceConfig = `
+data "switchcloud_support_request" "test" {
+  reference_number = "SR-2024-000042"
+}
+`
+
+const testAccSupportRequestDataSourceIdConfig = `
+data "switchcloud_support_request" "test" {
+  id = "6f1d2c3b-4a5e-4f6d-8c7b-9a0e1d2c3b4a"
+}
+`
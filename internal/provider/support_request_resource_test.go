@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSupportRequestResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSupportRequestResourceInvalidCategoryConfig,
+				ExpectError: regexp.MustCompile("'category' must be one of"),
+			},
+			{
+				Config: testAccSupportRequestResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_support_request.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_support_request.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("open"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_support_request.test",
+						tfjsonpath.New("reference_number"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^SR-\d{4}-\d{6}$`)),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_support_request.test",
+						tfjsonpath.New("resolution"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_support_request.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A support request cannot be changed, a new one is opened instead
+			{
+				Config: testAccSupportRequestResourceUpdateConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("switchcloud_support_request.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+const testAccSupportRequestResourceInvalidCategoryConfig = `
+resource "switchcloud_support_request" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  category   = "gpu"
+  subject    = "Access to GPU flavours"
+  body       = "We need GPU flavours for training models."
+}
+`
+
+const testAccSupportRequestResourceConfig = `
+resource "switchcloud_support_request" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  category   = "quota"
+  subject    = "Increase cores in ZH"
+  body       = "We need 512 cores in ZH for a simulation campaign."
+}
+`
+
+const testAccSupportRequestResourceUpdateConfig = `
+resource "switchcloud_support_request" "test" {
+  project_id = "0faaecfb-d154-4f8f-bdc8-fccd630ddb39"
+  category   = "quota"
+  subject    = "Increase cores in ZH"
+  body       = "We need 1024 cores in ZH for a simulation campaign."
+}
+`
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// buildTLSConfig returns the TLS client configuration described by the provider
+// configuration. Custom CA certificates are added on top of the system roots so
+// that public endpoints keep working when a private CA is configured.
+func buildTLSConfig(data SwitchcloudProviderModel) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	if !data.CaCertFile.IsNull() && !data.CaCertPem.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Configuration Error",
+			"Only one of 'ca_cert_file' or 'ca_cert_pem' can be provided.",
+		)
+		return nil, diags
+	}
+
+	var caCert []byte
+	if !data.CaCertFile.IsNull() {
+		pem, err := os.ReadFile(data.CaCertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Configuration Error",
+				fmt.Sprintf("Unable to read CA certificate file, got error: %s", err),
+			)
+			return nil, diags
+		}
+		caCert = pem
+	}
+	if !data.CaCertPem.IsNull() {
+		caCert = []byte(data.CaCertPem.ValueString())
+	}
+
+	if caCert != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			diags.AddError(
+				"Configuration Error",
+				"The configured CA certificate does not contain any valid PEM-encoded certificates.",
+			)
+			return nil, diags
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if data.ClientCert.IsNull() != data.ClientKey.IsNull() {
+		diags.AddError(
+			"Configuration Error",
+			"Both 'client_cert' and 'client_key' must be provided for mutual TLS.",
+		)
+		return nil, diags
+	}
+
+	if !data.ClientCert.IsNull() {
+		cert, err := tls.X509KeyPair([]byte(data.ClientCert.ValueString()), []byte(data.ClientKey.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Configuration Error",
+				fmt.Sprintf("Unable to load client certificate, got error: %s", err),
+			)
+			return nil, diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, diags
+}
+
+// buildProxyFunc returns the proxy selection function described by the provider
+// configuration, or nil if the proxy should be taken from the environment.
+func buildProxyFunc(ctx context.Context, data SwitchcloudProviderModel) (func(*http.Request) (*url.URL, error), diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.ProxyUrl.IsNull() {
+		if !data.ProxyUsername.IsNull() || !data.ProxyPassword.IsNull() || !data.NoProxy.IsNull() {
+			diags.AddError(
+				"Configuration Error",
+				"'proxy_username', 'proxy_password' and 'no_proxy' can only be provided together with 'proxy_url'.",
+			)
+		}
+		return nil, diags
+	}
+
+	proxyUrl, err := url.Parse(data.ProxyUrl.ValueString())
+	if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+		diags.AddAttributeError(
+			path.Root("proxy_url"),
+			"Configuration Error",
+			fmt.Sprintf("Expected an absolute proxy URL such as http://proxy.example.com:3128. Got: %s", data.ProxyUrl.ValueString()),
+		)
+		return nil, diags
+	}
+
+	if !data.ProxyUsername.IsNull() {
+		if data.ProxyPassword.IsNull() {
+			proxyUrl.User = url.User(data.ProxyUsername.ValueString())
+		} else {
+			proxyUrl.User = url.UserPassword(data.ProxyUsername.ValueString(), data.ProxyPassword.ValueString())
+		}
+	}
+
+	var noProxy []string
+	if !data.NoProxy.IsNull() {
+		diags.Append(data.NoProxy.ElementsAs(ctx, &noProxy, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  proxyUrl.String(),
+		HTTPSProxy: proxyUrl.String(),
+		NoProxy:    strings.Join(noProxy, ","),
+	}
+	proxyFunc := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, diags
+}
+
+// newTransport returns a copy of the default HTTP transport using the given TLS
+// configuration and, if not nil, proxy selection function.
+func newTransport(tlsConfig *tls.Config, proxy func(*http.Request) (*url.URL, error)) (*http.Transport, error) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("expected *http.Transport as default transport, got: %T", http.DefaultTransport)
+	}
+
+	transport := defaultTransport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	if proxy != nil {
+		transport.Proxy = proxy
+	}
+
+	return transport, nil
+}
+
+// requestIdHeader is the header used to correlate requests with the SwitchCloud API logs.
+const requestIdHeader = "X-Request-ID"
+
+// userAgentTransport is a custom HTTP transport that identifies the provider and
+// tags every request with a unique request ID.
+type userAgentTransport struct {
+	userAgent string
+	transport http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", t.userAgent)
+
+	if req.Header.Get(requestIdHeader) == "" {
+		requestId, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate request ID: %w", err)
+		}
+		req.Header.Set(requestIdHeader, requestId)
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// userAgent returns the User-Agent header sent with every request.
+func userAgent(providerVersion string, terraformVersion string, suffix string) string {
+	ua := fmt.Sprintf("terraform-provider-switchcloud/%s terraform/%s", providerVersion, terraformVersion)
+	if suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
+
+// withRequestId appends the request ID sent with req to an error diagnostic detail,
+// so that failures can be reported to the SwitchCloud operators.
+func withRequestId(req *http.Request, detail string) string {
+	requestId := req.Header.Get(requestIdHeader)
+	if requestId == "" {
+		return detail
+	}
+	return fmt.Sprintf("%s (request ID: %s)", detail, requestId)
+}
+
+// httpLogSubsystem is the tflog subsystem used for HTTP request and response logs.
+// Its level can be controlled separately via TF_LOG_PROVIDER_SWITCHCLOUD_HTTP.
+const httpLogSubsystem = "http"
+
+// defaultRedactedFields are header and JSON field names whose values are never logged.
+var defaultRedactedFields = []string{
+	"Authorization",
+	"api_key",
+	"password",
+	"secret",
+	"secret_key",
+	"signing_secret",
+	"token",
+}
+
+// httpLogLevelEnvVars are the environment variables that set the level of the http
+// log subsystem, from the most to the least specific.
+var httpLogLevelEnvVars = []string{
+	"TF_LOG_PROVIDER_SWITCHCLOUD_HTTP",
+	"TF_LOG_PROVIDER_SWITCHCLOUD",
+	"TF_LOG_PROVIDER",
+	"TF_LOG",
+}
+
+// httpBodyLoggingEnabled reports whether the http log subsystem logs at debug level,
+// using the most specific log level environment variable that is set.
+func httpBodyLoggingEnabled() bool {
+	for _, envVar := range httpLogLevelEnvVars {
+		level := strings.ToUpper(os.Getenv(envVar))
+		if level == "" {
+			continue
+		}
+		return level == "DEBUG" || level == "TRACE" || level == "JSON"
+	}
+	return false
+}
+
+// loggingTransport is a custom HTTP transport that logs requests and responses.
+// Bodies are only read and logged if logBodies is set, and sensitive values are masked.
+type loggingTransport struct {
+	redactedFields []string
+	logBodies      bool
+	transport      http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_SWITCHCLOUD_HTTP"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, httpLogSubsystem, t.redactedFields...)
+
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"request_id": req.Header.Get(requestIdHeader),
+	}
+
+	headers := map[string]interface{}{}
+	for key := range req.Header {
+		headers[key] = req.Header.Get(key)
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "sending HTTP request headers", headers)
+
+	if t.logBodies && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			requestBody, err := io.ReadAll(body)
+			body.Close()
+			if err == nil && len(requestBody) > 0 {
+				tflog.SubsystemDebug(ctx, httpLogSubsystem, "sending HTTP request body", map[string]interface{}{
+					"request_id": fields["request_id"],
+					"body":       redactBody(requestBody, t.redactedFields),
+				})
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemError(ctx, httpLogSubsystem, "HTTP request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.SubsystemInfo(ctx, httpLogSubsystem, "received HTTP response", fields)
+
+	// Buffering the response body is only needed to log it
+	if !t.logBodies {
+		return resp, nil
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	if len(responseBody) > 0 {
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "received HTTP response body", map[string]interface{}{
+			"request_id": fields["request_id"],
+			"body":       redactBody(responseBody, t.redactedFields),
+		})
+	}
+
+	return resp, nil
+}
+
+// redactBody returns body with the values of all redacted JSON fields masked.
+// Bodies that are not valid JSON are returned unchanged.
+func redactBody(body []byte, redactedFields []string) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(value, redactedFields))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+func redactValue(value interface{}, redactedFields []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isRedactedField(key, redactedFields) {
+				v[key] = "***"
+			} else {
+				v[key] = redactValue(field, redactedFields)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, redactedFields)
+		}
+	}
+	return value
+}
+
+func isRedactedField(key string, redactedFields []string) bool {
+	for _, field := range redactedFields {
+		if strings.EqualFold(key, field) {
+			return true
+		}
+	}
+	return false
+}
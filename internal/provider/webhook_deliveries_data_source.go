@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebhookDeliveriesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &WebhookDeliveriesDataSource{}
+
+func NewWebhookDeliveriesDataSource() datasource.DataSource {
+	return &WebhookDeliveriesDataSource{}
+}
+
+// WebhookDeliveriesDataSource defines the data source implementation.
+type WebhookDeliveriesDataSource struct {
+	client   *http.Client
+	endpoint string
+}
+
+// WebhookDeliveriesDataSourceModel describes the data source data model.
+type WebhookDeliveriesDataSourceModel struct {
+	WebhookId  types.String           `tfsdk:"webhook_id"`
+	Limit      types.Int64            `tfsdk:"limit"`
+	Deliveries []WebhookDeliveryModel `tfsdk:"deliveries"`
+}
+
+// WebhookDeliveryModel describes a delivery of an event to a webhook.
+type WebhookDeliveryModel struct {
+	Id           types.String `tfsdk:"id"`
+	Event        types.String `tfsdk:"event"`
+	Status       types.String `tfsdk:"status"`
+	ResponseCode types.Int64  `tfsdk:"response_code"`
+	Attempts     types.Int64  `tfsdk:"attempts"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// WebhookDelivery represents the API response structure.
+type WebhookDelivery struct {
+	Id           string `json:"id"`
+	WebhookId    string `json:"webhook_id"`
+	Event        string `json:"event"`
+	Status       string `json:"status"`
+	ResponseCode *int64 `json:"response_code"`
+	Attempts     int64  `json:"attempts"`
+	CreatedAt    string `json:"created_at"`
+}
+
+func (d *WebhookDeliveriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_deliveries"
+}
+
+func (d *WebhookDeliveriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Recent deliveries of events to a webhook in the Switchcloud platform, newest first",
+
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				MarkdownDescription: "Webhook ID whose deliveries are listed",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of deliveries to list, between 1 and 100. Defaults to the API default of 20.",
+				Optional:            true,
+			},
+			"deliveries": schema.ListNestedAttribute{
+				MarkdownDescription: "Deliveries to the webhook",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Delivery identifier",
+							Computed:            true,
+						},
+						"event": schema.StringAttribute{
+							MarkdownDescription: "Event type that was delivered",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the delivery, one of `pending`, `succeeded` or `failed`",
+							Computed:            true,
+						},
+						"response_code": schema.Int64Attribute{
+							MarkdownDescription: "HTTP status code returned by the webhook on the last attempt, if any",
+							Computed:            true,
+						},
+						"attempts": schema.Int64Attribute{
+							MarkdownDescription: "Number of delivery attempts",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the event was first delivered",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhookDeliveriesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config WebhookDeliveriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Limit.IsNull() && !config.Limit.IsUnknown() && (config.Limit.ValueInt64() < 1 || config.Limit.ValueInt64() > 100) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Configuration Error",
+			fmt.Sprintf("'limit' must be between 1 and 100. Got: %d", config.Limit.ValueInt64()),
+		)
+	}
+}
+
+func (d *WebhookDeliveriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	d.client = client
+	d.endpoint = endpoint
+}
+
+func (d *WebhookDeliveriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WebhookDeliveriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(d.endpoint, "/")+"/api/v1/webhooks/"+data.WebhookId.ValueString()+"/deliveries", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	if !data.Limit.IsNull() {
+		query := httpReq.URL.Query()
+		query.Set("limit", strconv.FormatInt(data.Limit.ValueInt64(), 10))
+		httpReq.URL.RawQuery = query.Encode()
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read webhook deliveries, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var deliveries []WebhookDelivery
+	if err := json.Unmarshal(body, &deliveries); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	data.Deliveries = make([]WebhookDeliveryModel, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		data.Deliveries = append(data.Deliveries, WebhookDeliveryModel{
+			Id:           types.StringValue(delivery.Id),
+			Event:        types.StringValue(delivery.Event),
+			Status:       types.StringValue(delivery.Status),
+			ResponseCode: types.Int64PointerValue(delivery.ResponseCode),
+			Attempts:     types.Int64Value(delivery.Attempts),
+			CreatedAt:    types.StringValue(delivery.CreatedAt),
+		})
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a webhook deliveries data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccWebhookDeliveriesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebhookDeliveriesDataSourceInvalidLimitConfig,
+				ExpectError: regexp.MustCompile("'limit' must be between 1 and 100"),
+			},
+			{
+				Config: testAccWebhookDeliveriesDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_webhook_deliveries.test",
+						tfjsonpath.New("deliveries"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_webhook_deliveries.test",
+						tfjsonpath.New("deliveries").AtSliceIndex(0).AtMapKey("event"),
+						knownvalue.StringExact("webhook.ping"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_webhook_deliveries.test",
+						tfjsonpath.New("deliveries").AtSliceIndex(0).AtMapKey("status"),
+						knownvalue.StringExact("succeeded"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.switchcloud_webhook_deliveries.test",
+						tfjsonpath.New("deliveries").AtSliceIndex(0).AtMapKey("response_code"),
+						knownvalue.Int64Exact(200),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccWebhookDeliveriesDataSourceInvalidLimitConfig = `
+data "switchcloud_webhook_deliveries" "test" {
+  webhook_id = "00000000-0000-0000-0000-000000000000"
+  limit      = 0
+}
+`
+
+const testAccWebhookDeliveriesDataSourceConfig = `
+resource "switchcloud_webhook" "test" {
+  url    = "https://hooks.example.org/switchcloud"
+  events = ["project.created"]
+}
+
+data "switchcloud_webhook_deliveries" "test" {
+  webhook_id = switchcloud_webhook.test.id
+  limit      = 10
+}
+`
@@ -0,0 +1,565 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookResource{}
+
+// webhookEvents are the event types a webhook can subscribe to.
+var webhookEvents = []string{
+	"project.created",
+	"project.updated",
+	"project.archived",
+	"project.member_added",
+	"project.member_removed",
+	"budget.threshold_reached",
+	"quota.request_approved",
+	"quota.request_rejected",
+}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+// WebhookResource defines the resource implementation.
+type WebhookResource struct {
+	client         *http.Client
+	endpoint       string
+	organisationId string
+}
+
+// WebhookResourceModel describes the resource data model.
+type WebhookResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	OrganisationId       types.String `tfsdk:"organisation_id"`
+	Url                  types.String `tfsdk:"url"`
+	Events               types.Set    `tfsdk:"events"`
+	SigningSecret        types.String `tfsdk:"signing_secret"`
+	SigningSecretVersion types.Int64  `tfsdk:"signing_secret_version"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// Webhook represents the API response structure. The signing secret is never returned.
+type Webhook struct {
+	Id             string   `json:"id"`
+	OrganisationId string   `json:"organisation_id"`
+	Url            string   `json:"url"`
+	Events         []string `json:"events"`
+	Enabled        bool     `json:"enabled"`
+	CreatedAt      string   `json:"created_at"`
+	UpdatedAt      string   `json:"updated_at"`
+}
+
+// WebhookRequest represents the request body for creating or updating a webhook.
+type WebhookRequest struct {
+	OrganisationId string   `json:"organisation_id,omitempty"`
+	Url            string   `json:"url"`
+	Events         []string `json:"events"`
+	SigningSecret  *string  `json:"signing_secret,omitempty"`
+	Enabled        bool     `json:"enabled"`
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "A webhook that receives events of the projects of an organisation in the Switchcloud platform, e.g. to post them to a chat or ticketing system.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Webhook identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organisation_id": schema.StringAttribute{
+				MarkdownDescription: "Organisation ID whose events are sent to the webhook. Defaults to the provider `organisation_id`, or the default organisation of the API key if neither is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL to which events are posted",
+				Required:            true,
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "Event types sent to the webhook, any of `" + strings.Join(webhookEvents, "`, `") + "`",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"signing_secret": schema.StringAttribute{
+				MarkdownDescription: "Secret used to sign the deliveries with an HMAC-SHA256 signature. This value is write-only and never stored in the state, " +
+					"change `signing_secret_version` to send a new secret. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"signing_secret_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `signing_secret`. The secret is only sent to the API when the webhook is created or this version changes. Removing both arguments clears the secret.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether events are sent to the webhook",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the webhook was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When the webhook was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WebhookResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Url.IsNull() && !config.Url.IsUnknown() {
+		webhookUrl, err := url.Parse(config.Url.ValueString())
+		if err != nil || (webhookUrl.Scheme != "https" && webhookUrl.Scheme != "http") || webhookUrl.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Configuration Error",
+				fmt.Sprintf("'url' must be an absolute http(s) URL. Got: %s", config.Url.ValueString()),
+			)
+		}
+	}
+
+	if !config.Events.IsNull() && !config.Events.IsUnknown() {
+		var events []types.String
+		resp.Diagnostics.Append(config.Events.ElementsAs(ctx, &events, false)...)
+
+		if len(events) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("events"),
+				"Configuration Error",
+				"'events' must contain at least one event type.",
+			)
+		}
+
+		for _, event := range events {
+			if event.IsUnknown() || event.IsNull() {
+				continue
+			}
+			if !slices.Contains(webhookEvents, event.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("events"),
+					"Configuration Error",
+					fmt.Sprintf("'events' must only contain %s. Got: %s", strings.Join(webhookEvents, ", "), event.ValueString()),
+				)
+			}
+		}
+	}
+
+	if !config.SigningSecretVersion.IsNull() && config.SigningSecret.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("signing_secret_version"),
+			"Configuration Error",
+			"'signing_secret_version' can only be set together with 'signing_secret'.",
+		)
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, ok := providerData["client"].(*http.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected HTTP Client Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", providerData["client"]),
+		)
+		return
+	}
+
+	endpoint, ok := providerData["endpoint"].(string)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Endpoint Type",
+			fmt.Sprintf("Expected string, got: %T. Please report this issue to the provider developers.", providerData["endpoint"]),
+		)
+		return
+	}
+
+	// The default organisation is optional
+	organisationId, _ := providerData["organisation_id"].(string)
+
+	r.client = client
+	r.endpoint = endpoint
+	r.organisationId = organisationId
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebhookResourceModel
+	var signingSecret types.String
+
+	// Read Terraform plan data into the model, the write-only secret is only part of the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("signing_secret"), &signingSecret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	createRequest, diags := data.request(ctx, signingSecret, nil)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource organisation overrides the provider default
+	if !data.OrganisationId.IsUnknown() && !data.OrganisationId.IsNull() {
+		createRequest.OrganisationId = data.OrganisationId.ValueString()
+	} else {
+		createRequest.OrganisationId = r.organisationId
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(createRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal create request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/webhooks", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to create webhook, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var webhook Webhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, webhook)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "created a webhook resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/webhooks/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read webhook, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check if webhook was deleted
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var webhook Webhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, webhook)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WebhookResourceModel
+	var signingSecret types.String
+
+	// Read Terraform plan and prior state data into the models, the write-only secret is only part of the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("signing_secret"), &signingSecret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API request body
+	updateRequest, diags := data.request(ctx, signingSecret, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Marshal request body
+	jsonBody, err := json.Marshal(updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal update request, got error: %s", err))
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "PUT", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/webhooks/"+data.Id.ValueString(), bytes.NewBuffer(jsonBody))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to update webhook, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	// Parse response
+	var webhook Webhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to parse response, got error: %s", err)))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(data.update(ctx, webhook)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a webhook resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", strings.TrimSuffix(r.endpoint, "/")+"/api/v1/webhooks/"+data.Id.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request, got error: %s", err))
+		return
+	}
+
+	httpReq.Header.Set("Accept", "application/json")
+
+	// Make API call
+	httpResp, err := r.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to delete webhook, got error: %s", err)))
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("Unable to read response body, got error: %s", err)))
+		return
+	}
+
+	// Check response status, a webhook that is already gone is fine
+	if httpResp.StatusCode != http.StatusNoContent && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("Client Error", withRequestId(httpReq, fmt.Sprintf("API returned status %d: %s", httpResp.StatusCode, string(body))))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a webhook resource")
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// request builds the API request body from the model, without the organisation. The write-only
+// signing secret is sent when the webhook is created, i.e. without prior state, or when its version
+// changes. The API keeps the current secret unless a new one is sent, so an empty secret clears it.
+func (m *WebhookResourceModel) request(ctx context.Context, signingSecret types.String, prior *WebhookResourceModel) (WebhookRequest, diag.Diagnostics) {
+	request := WebhookRequest{
+		Url:     m.Url.ValueString(),
+		Events:  []string{},
+		Enabled: m.Enabled.ValueBool(),
+	}
+
+	diags := m.Events.ElementsAs(ctx, &request.Events, false)
+
+	switch {
+	case prior == nil:
+		request.SigningSecret = signingSecret.ValueStringPointer()
+	case !m.SigningSecretVersion.Equal(prior.SigningSecretVersion):
+		secret := signingSecret.ValueString()
+		request.SigningSecret = &secret
+	}
+
+	return request, diags
+}
+
+// update sets the model from the API response.
+func (m *WebhookResourceModel) update(ctx context.Context, webhook Webhook) diag.Diagnostics {
+	events, diags := types.SetValueFrom(ctx, types.StringType, webhook.Events)
+
+	m.Id = types.StringValue(webhook.Id)
+	m.OrganisationId = types.StringValue(webhook.OrganisationId)
+	m.Url = types.StringValue(webhook.Url)
+	m.Events = events
+	m.Enabled = types.BoolValue(webhook.Enabled)
+	m.CreatedAt = types.StringValue(webhook.CreatedAt)
+	m.UpdatedAt = types.StringValue(webhook.UpdatedAt)
+
+	return diags
+}
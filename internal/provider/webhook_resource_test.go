@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebhookResourceInvalidConfig,
+				ExpectError: regexp.MustCompile("'events' must only contain"),
+			},
+			{
+				Config: testAccWebhookResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("organisation_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("events"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("project.created"),
+							knownvalue.StringExact("project.archived"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				ResourceName:      "switchcloud_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebhookResourceUpdateConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("url"),
+						knownvalue.StringExact("https://hooks.example.org/switchcloud/v2"),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("events"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("budget.threshold_reached"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccWebhookResourceSigningSecret(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceSigningSecretConfig("first-secret", 1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("signing_secret"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("signing_secret_version"),
+						knownvalue.Int64Exact(1),
+					),
+				},
+			},
+			{
+				Config: testAccWebhookResourceSigningSecretConfig("second-secret", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("signing_secret"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"switchcloud_webhook.test",
+						tfjsonpath.New("signing_secret_version"),
+						knownvalue.Int64Exact(2),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestWebhookResourceModelRequest(t *testing.T) {
+	ctx := context.Background()
+	model := func(version types.Int64) *WebhookResourceModel {
+		return &WebhookResourceModel{
+			Url:                  types.StringValue("https://hooks.example.org/switchcloud"),
+			Events:               types.SetValueMust(types.StringType, []attr.Value{types.StringValue("project.created")}),
+			Enabled:              types.BoolValue(true),
+			SigningSecretVersion: version,
+		}
+	}
+	body := func(t *testing.T, m *WebhookResourceModel, signingSecret types.String, prior *WebhookResourceModel) string {
+		request, diags := m.request(ctx, signingSecret, prior)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		jsonBody, err := json.Marshal(request)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return string(jsonBody)
+	}
+
+	t.Run("create with secret", func(t *testing.T) {
+		got := body(t, model(types.Int64Value(1)), types.StringValue("first-secret"), nil)
+		if !strings.Contains(got, `"signing_secret":"first-secret"`) {
+			t.Fatalf("expected the secret to be sent, got: %s", got)
+		}
+	})
+
+	t.Run("create without secret", func(t *testing.T) {
+		got := body(t, model(types.Int64Null()), types.StringNull(), nil)
+		if strings.Contains(got, "signing_secret") {
+			t.Fatalf("expected no secret to be sent, got: %s", got)
+		}
+	})
+
+	t.Run("update with same version", func(t *testing.T) {
+		got := body(t, model(types.Int64Value(1)), types.StringValue("first-secret"), model(types.Int64Value(1)))
+		if strings.Contains(got, "signing_secret") {
+			t.Fatalf("expected the current secret to be kept, got: %s", got)
+		}
+	})
+
+	t.Run("update with new version", func(t *testing.T) {
+		got := body(t, model(types.Int64Value(2)), types.StringValue("second-secret"), model(types.Int64Value(1)))
+		if !strings.Contains(got, `"signing_secret":"second-secret"`) {
+			t.Fatalf("expected the new secret to be sent, got: %s", got)
+		}
+	})
+
+	t.Run("update with removed secret", func(t *testing.T) {
+		got := body(t, model(types.Int64Null()), types.StringNull(), model(types.Int64Value(1)))
+		if !strings.Contains(got, `"signing_secret":""`) {
+			t.Fatalf("expected the secret to be cleared, got: %s", got)
+		}
+	})
+}
+
+const testAccWebhookResourceInvalidConfig = `
+resource "switchcloud_webhook" "test" {
+  url    = "https://hooks.example.org/switchcloud"
+  events = ["project.deleted"]
+}
+`
+
+const testAccWebhookResourceConfig = `
+resource "switchcloud_webhook" "test" {
+  url    = "https://hooks.example.org/switchcloud"
+  events = ["project.created", "project.archived"]
+}
+`
+
+const testAccWebhookResourceUpdateConfig = `
+resource "switchcloud_webhook" "test" {
+  url     = "https://hooks.example.org/switchcloud/v2"
+  events  = ["budget.threshold_reached"]
+  enabled = false
+}
+`
+
+func testAccWebhookResourceSigningSecretConfig(secret string, version int) string {
+	return fmt.Sprintf(`
+resource "switchcloud_webhook" "test" {
+  url                    = "https://hooks.example.org/switchcloud"
+  events                 = ["project.created"]
+  signing_secret         = %[1]q
+  signing_secret_version = %[2]d
+}
+`, secret, version)
+}
@@ -4,11 +4,15 @@
 package main
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-faker/faker/v4"
@@ -16,60 +20,382 @@ import (
 )
 
 type Project struct {
+	Id                    string                      `json:"id"`
+	Name                  string                      `json:"name"`
+	Description           *string                     `json:"description,omitempty"`
+	OrganisationId        string                      `json:"organisation_id"`
+	BillingAccountId      *string                     `json:"billing_account_id,omitempty"`
+	PrincipalInvestigator *string                     `json:"principal_investigator,omitempty"`
+	TechnicalContact      *string                     `json:"technical_contact,omitempty"`
+	EndDate               *string                     `json:"end_date,omitempty"`
+	Purpose               *string                     `json:"purpose,omitempty"`
+	ExpiresAt             *string                     `json:"expires_at,omitempty"`
+	Labels                map[string]string           `json:"labels,omitempty"`
+	OpenstackProjects     map[string]OpenstackProject `json:"openstack_projects,omitempty"`
+	Archived              bool                        `json:"archived"`
+	ArchivedAt            string                      `json:"archived_at"`
+	CreatedAt             string                      `json:"created_at"`
+	UpdatedAt             string                      `json:"updated_at"`
+}
+
+type OpenstackProject struct {
+	ProjectId string `json:"project_id"`
+	Domain    string `json:"domain"`
+	AuthUrl   string `json:"auth_url"`
+}
+
+type BillingAccount struct {
+	Id             string `json:"id"`
+	CostCentre     string `json:"cost_centre"`
+	Name           string `json:"name"`
+	OrganisationId string `json:"organisation_id"`
+	Active         bool   `json:"active"`
+}
+
+type ProjectMember struct {
+	Id               string  `json:"id"`
+	ProjectId        string  `json:"project_id"`
+	UserId           string  `json:"user_id"`
+	EMail            string  `json:"email"`
+	ServiceAccountId *string `json:"service_account_id,omitempty"`
+	GroupId          *string `json:"group_id,omitempty"`
+	Role             string  `json:"role"`
+	DisplayName      string  `json:"display_name"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type ProjectMemberResponse struct {
+	Id               string                     `json:"id"`
+	ProjectId        string                     `json:"project_id"`
+	UserId           string                     `json:"user_id"`
+	ServiceAccountId *string                    `json:"service_account_id,omitempty"`
+	GroupId          *string                    `json:"group_id,omitempty"`
+	Role             string                     `json:"role"`
+	CreatedAt        string                     `json:"created_at"`
+	UpdatedAt        string                     `json:"updated_at"`
+	Links            ProjectMemberResponseLinks `json:"links"`
+	User             ProjectMemberResponseUser  `json:"user"`
+}
+
+type ProjectMemberResponseLinks struct {
+	Project string `json:"project"`
+}
+
+type ProjectMemberResponseUser struct {
+	Id          string `json:"id"`
+	Email       string `json:"email"`
+	DisplayName string `json:"display_name"`
+}
+
+type ProjectBudget struct {
+	Id              string  `json:"id"`
+	ProjectId       string  `json:"project_id"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	Period          string  `json:"period"`
+	HardLimit       bool    `json:"hard_limit"`
+	AlertThresholds []int64 `json:"alert_thresholds"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type BudgetAlert struct {
+	Id          string   `json:"id"`
+	ProjectId   string   `json:"project_id"`
+	BudgetId    string   `json:"budget_id"`
+	Thresholds  []int64  `json:"thresholds"`
+	Emails      []string `json:"emails"`
+	WebhookUrl  *string  `json:"webhook_url,omitempty"`
+	MemberRoles []string `json:"member_roles"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+type ProjectUsage struct {
+	ProjectId string             `json:"project_id"`
+	StartDate string             `json:"start_date"`
+	EndDate   string             `json:"end_date"`
+	TotalCost float64            `json:"total_cost"`
+	Currency  string             `json:"currency"`
+	Usage     []ProjectUsageItem `json:"usage"`
+}
+
+type ProjectUsageItem struct {
+	Service  string  `json:"service"`
+	Region   string  `json:"region"`
+	Quantity float64 `json:"quantity"`
+	Unit     string  `json:"unit"`
+	Cost     float64 `json:"cost"`
+}
+
+type ProjectQuota struct {
+	ProjectId     string              `json:"project_id"`
+	Region        string              `json:"region"`
+	Quota         QuotaValues         `json:"quota"`
+	Usage         QuotaValues         `json:"usage"`
+	ChangeRequest *QuotaChangeRequest `json:"change_request,omitempty"`
+}
+
+type QuotaValues struct {
+	Instances       int64 `json:"instances"`
+	Cores           int64 `json:"cores"`
+	RamMb           int64 `json:"ram_mb"`
+	Volumes         int64 `json:"volumes"`
+	FloatingIps     int64 `json:"floating_ips"`
+	ObjectStorageGb int64 `json:"object_storage_gb"`
+}
+
+type QuotaChangeRequest struct {
+	Id        string                   `json:"id"`
+	Status    string                   `json:"status"`
+	Reason    *string                  `json:"reason,omitempty"`
+	Quota     QuotaChangeRequestValues `json:"quota"`
+	CreatedAt string                   `json:"created_at"`
+}
+
+type QuotaChangeCreateRequest struct {
+	Quota  QuotaChangeRequestValues `json:"quota"`
+	Reason *string                  `json:"reason,omitempty"`
+}
+
+type QuotaChangeRequestValues struct {
+	Instances       *int64 `json:"instances,omitempty"`
+	Cores           *int64 `json:"cores,omitempty"`
+	RamMb           *int64 `json:"ram_mb,omitempty"`
+	Volumes         *int64 `json:"volumes,omitempty"`
+	FloatingIps     *int64 `json:"floating_ips,omitempty"`
+	ObjectStorageGb *int64 `json:"object_storage_gb,omitempty"`
+}
+
+type Region struct {
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	AuthUrl  string   `json:"auth_url"`
+	Services []string `json:"services"`
+	Status   string   `json:"status"`
+}
+
+type ApplicationCredential struct {
+	Id          string                            `json:"id"`
+	ProjectId   string                            `json:"project_id"`
+	Region      string                            `json:"region"`
+	Name        string                            `json:"name"`
+	Description *string                           `json:"description,omitempty"`
+	ExpiresAt   *string                           `json:"expires_at,omitempty"`
+	Roles       []string                          `json:"roles"`
+	AccessRules []ApplicationCredentialAccessRule `json:"access_rules"`
+	Secret      string                            `json:"secret,omitempty"`
+	CreatedAt   string                            `json:"created_at"`
+}
+
+type ApplicationCredentialAccessRule struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+	Path    string `json:"path"`
+}
+
+type S3Credential struct {
+	AccessKey string `json:"access_key"`
+	ProjectId string `json:"project_id"`
+	UserId    string `json:"user_id"`
+	SecretKey string `json:"secret_key,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ServiceAccount struct {
 	Id             string  `json:"id"`
 	Name           string  `json:"name"`
 	Description    *string `json:"description,omitempty"`
 	OrganisationId string  `json:"organisation_id"`
-	Archived       bool    `json:"archived"`
-	ArchivedAt     string  `json:"archived_at"`
+	Enabled        bool    `json:"enabled"`
 	CreatedAt      string  `json:"created_at"`
 	UpdatedAt      string  `json:"updated_at"`
 }
 
-type ProjectMember struct {
+type ServiceAccountKey struct {
+	Id               string   `json:"id"`
+	ServiceAccountId string   `json:"service_account_id"`
+	Description      *string  `json:"description,omitempty"`
+	ExpiresAt        *string  `json:"expires_at,omitempty"`
+	Scopes           []string `json:"scopes"`
+	Token            string   `json:"token,omitempty"`
+	CreatedAt        string   `json:"created_at"`
+}
+
+type Group struct {
+	Id             string  `json:"id"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	OrganisationId string  `json:"organisation_id"`
+	MemberCount    int64   `json:"member_count"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+type GroupMember struct {
 	Id          string `json:"id"`
-	ProjectId   string `json:"project_id"`
+	GroupId     string `json:"group_id"`
 	UserId      string `json:"user_id"`
 	EMail       string `json:"email"`
 	DisplayName string `json:"display_name"`
 	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
 }
 
-type ProjectMemberResponse struct {
-	Id        string                     `json:"id"`
-	ProjectId string                     `json:"project_id"`
-	UserId    string                     `json:"user_id"`
-	CreatedAt string                     `json:"created_at"`
-	UpdatedAt string                     `json:"updated_at"`
-	Links     ProjectMemberResponseLinks `json:"links"`
-	User      ProjectMemberResponseUser  `json:"user"`
+type GroupMemberResponse struct {
+	Id        string                    `json:"id"`
+	GroupId   string                    `json:"group_id"`
+	UserId    string                    `json:"user_id"`
+	CreatedAt string                    `json:"created_at"`
+	User      ProjectMemberResponseUser `json:"user"`
 }
 
-type ProjectMemberResponseLinks struct {
-	Project string `json:"project"`
+type OrganisationMember struct {
+	Id             string `json:"id"`
+	OrganisationId string `json:"organisation_id"`
+	UserId         string `json:"user_id"`
+	EMail          string `json:"email"`
+	Role           string `json:"role"`
+	DisplayName    string `json:"display_name"`
+	CreatedAt      string `json:"created_at"`
 }
 
-type ProjectMemberResponseUser struct {
-	Id          string `json:"id"`
-	Email       string `json:"email"`
-	DisplayName string `json:"display_name"`
+type Webhook struct {
+	Id             string   `json:"id"`
+	OrganisationId string   `json:"organisation_id"`
+	Url            string   `json:"url"`
+	Events         []string `json:"events"`
+	SigningSecret  string   `json:"signing_secret,omitempty"`
+	Enabled        bool     `json:"enabled"`
+	CreatedAt      string   `json:"created_at"`
+	UpdatedAt      string   `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	Id           string `json:"id"`
+	WebhookId    string `json:"webhook_id"`
+	Event        string `json:"event"`
+	Status       string `json:"status"`
+	ResponseCode *int64 `json:"response_code"`
+	Attempts     int64  `json:"attempts"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type SshKey struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	PublicKey   string  `json:"public_key"`
+	ProjectId   *string `json:"project_id"`
+	Fingerprint string  `json:"fingerprint"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type SupportRequest struct {
+	Id              string  `json:"id"`
+	ProjectId       string  `json:"project_id"`
+	Category        string  `json:"category"`
+	Subject         string  `json:"subject"`
+	Body            string  `json:"body"`
+	Status          string  `json:"status"`
+	ReferenceNumber string  `json:"reference_number"`
+	Resolution      *string `json:"resolution"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type AuditEvent struct {
+	Id             string            `json:"id"`
+	EventType      string            `json:"event_type"`
+	ActorId        string            `json:"actor_id"`
+	ActorEMail     string            `json:"actor_email"`
+	OrganisationId string            `json:"organisation_id"`
+	ProjectId      *string           `json:"project_id"`
+	TargetType     string            `json:"target_type"`
+	TargetId       string            `json:"target_id"`
+	Details        map[string]string `json:"details"`
+	OccurredAt     string            `json:"occurred_at"`
+}
+
+type AuditEventPage struct {
+	Events        []AuditEvent `json:"events"`
+	NextPageToken string       `json:"next_page_token"`
+}
+
+type OrganisationMemberResponse struct {
+	Id             string                    `json:"id"`
+	OrganisationId string                    `json:"organisation_id"`
+	UserId         string                    `json:"user_id"`
+	Role           string                    `json:"role"`
+	CreatedAt      string                    `json:"created_at"`
+	User           ProjectMemberResponseUser `json:"user"`
 }
 
 var orgId string = faker.UUIDHyphenated()
 var projects map[string]Project = make(map[string]Project)
+var billingAccounts map[string]BillingAccount = make(map[string]BillingAccount)
 var projectMembers map[string]ProjectMember = make(map[string]ProjectMember)
+var projectBudgets map[string]ProjectBudget = make(map[string]ProjectBudget)
+var budgetAlerts map[string]BudgetAlert = make(map[string]BudgetAlert)
+var projectQuotas map[string]ProjectQuota = make(map[string]ProjectQuota)
+var applicationCredentials map[string]ApplicationCredential = make(map[string]ApplicationCredential)
+var s3Credentials map[string]S3Credential = make(map[string]S3Credential)
+var serviceAccounts map[string]ServiceAccount = make(map[string]ServiceAccount)
+var serviceAccountKeys map[string]ServiceAccountKey = make(map[string]ServiceAccountKey)
+var groups map[string]Group = make(map[string]Group)
+var groupMembers map[string]GroupMember = make(map[string]GroupMember)
+var organisationMembers map[string]OrganisationMember = make(map[string]OrganisationMember)
+var webhooks map[string]Webhook = make(map[string]Webhook)
+var webhookDeliveries map[string]WebhookDelivery = make(map[string]WebhookDelivery)
+var auditEvents []AuditEvent = []AuditEvent{}
+var sshKeys map[string]SshKey = make(map[string]SshKey)
+var supportRequests map[string]SupportRequest = make(map[string]SupportRequest)
+var supportRequestCount int = 0
+var regions []Region = []Region{
+	{Id: "ZH", Name: "Zurich", AuthUrl: "https://zh.cloud.switch.ch:5000/v3", Services: []string{"compute", "volume", "network", "image", "object_storage"}, Status: "available"},
+	{Id: "LS", Name: "Lausanne", AuthUrl: "https://ls.cloud.switch.ch:5000/v3", Services: []string{"compute", "volume", "network", "image"}, Status: "available"},
+}
 
 func handleDebug(w http.ResponseWriter, r *http.Request) {
 
 	type debugResponse struct {
-		Projects      map[string]Project       `json:"projects"`
-		ProjectMember map[string]ProjectMember `json:"project_members"`
+		Projects               map[string]Project               `json:"projects"`
+		ProjectMember          map[string]ProjectMember         `json:"project_members"`
+		ProjectBudgets         map[string]ProjectBudget         `json:"project_budgets"`
+		BudgetAlerts           map[string]BudgetAlert           `json:"budget_alerts"`
+		ProjectQuotas          map[string]ProjectQuota          `json:"project_quotas"`
+		ApplicationCredentials map[string]ApplicationCredential `json:"application_credentials"`
+		S3Credentials          map[string]S3Credential          `json:"s3_credentials"`
+		ServiceAccounts        map[string]ServiceAccount        `json:"service_accounts"`
+		ServiceAccountKeys     map[string]ServiceAccountKey     `json:"service_account_keys"`
+		Groups                 map[string]Group                 `json:"groups"`
+		GroupMembers           map[string]GroupMember           `json:"group_members"`
+		OrganisationMembers    map[string]OrganisationMember    `json:"organisation_members"`
+		Webhooks               map[string]Webhook               `json:"webhooks"`
+		WebhookDeliveries      map[string]WebhookDelivery       `json:"webhook_deliveries"`
+		AuditEvents            []AuditEvent                     `json:"audit_events"`
+		SshKeys                map[string]SshKey                `json:"ssh_keys"`
+		SupportRequests        map[string]SupportRequest        `json:"support_requests"`
 	}
 
 	var response = debugResponse{
-		Projects:      projects,
-		ProjectMember: projectMembers,
+		Projects:               projects,
+		ProjectMember:          projectMembers,
+		ProjectBudgets:         projectBudgets,
+		BudgetAlerts:           budgetAlerts,
+		ProjectQuotas:          projectQuotas,
+		ApplicationCredentials: applicationCredentials,
+		S3Credentials:          s3Credentials,
+		ServiceAccounts:        serviceAccounts,
+		ServiceAccountKeys:     serviceAccountKeys,
+		Groups:                 groups,
+		GroupMembers:           groupMembers,
+		OrganisationMembers:    organisationMembers,
+		Webhooks:               webhooks,
+		WebhookDeliveries:      webhookDeliveries,
+		AuditEvents:            auditEvents,
+		SshKeys:                sshKeys,
+		SupportRequests:        supportRequests,
 	}
 
 	p, err := json.MarshalIndent(response, "", "  ")
@@ -91,7 +417,15 @@ func handlePostProject(w http.ResponseWriter, r *http.Request) {
 	}
 
 	p.Id = faker.UUIDHyphenated()
-	p.OrganisationId = orgId
+	if p.OrganisationId == "" {
+		p.OrganisationId = orgId
+	}
+	// Projects are billed to the default billing account of the organisation unless set
+	if p.BillingAccountId == nil && p.OrganisationId == orgId {
+		defaultBillingAccountId := "9d0c6f1e-8a43-4d38-9f5e-2b7c1e0a4f11"
+		p.BillingAccountId = &defaultBillingAccountId
+	}
+	p.OpenstackProjects = newOpenstackProjects()
 	p.CreatedAt = time.Now().Format(time.RFC3339)
 	p.UpdatedAt = time.Now().Format(time.RFC3339)
 
@@ -103,6 +437,19 @@ func handlePostProject(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(p)
 }
 
+// newOpenstackProjects creates an OpenStack project in every region.
+func newOpenstackProjects() map[string]OpenstackProject {
+	openstackProjects := make(map[string]OpenstackProject)
+	for _, region := range regions {
+		openstackProjects[region.Id] = OpenstackProject{
+			ProjectId: strings.ReplaceAll(faker.UUIDHyphenated(), "-", ""),
+			Domain:    "Default",
+			AuthUrl:   region.AuthUrl,
+		}
+	}
+	return openstackProjects
+}
+
 func handleGetProject(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	id := vars["id"]
@@ -117,6 +464,111 @@ func handleGetProject(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(projects[id])
 }
 
+func handlePutProject(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := projects[id]
+	if !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var p Project
+	err := decoder.Decode(&p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if p.BillingAccountId != nil {
+		if _, ok := billingAccounts[*p.BillingAccountId]; !ok {
+			http.Error(w, "Billing Account not found", http.StatusBadRequest)
+			return
+		}
+	}
+
+	existing.Name = p.Name
+	existing.Description = p.Description
+	existing.BillingAccountId = p.BillingAccountId
+	existing.PrincipalInvestigator = p.PrincipalInvestigator
+	existing.TechnicalContact = p.TechnicalContact
+	existing.EndDate = p.EndDate
+	existing.Purpose = p.Purpose
+	existing.ExpiresAt = p.ExpiresAt
+	existing.Labels = p.Labels
+	existing.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	projects[id] = existing
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Project: %+v\n", existing)
+	json.NewEncoder(w).Encode(existing)
+}
+
+func handlePostProjectTransfer(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := projects[id]
+	if !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var p Project
+	err := decoder.Decode(&p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if p.OrganisationId == "" || p.OrganisationId == existing.OrganisationId {
+		http.Error(w, "Project already belongs to the organisation", http.StatusBadRequest)
+		return
+	}
+
+	existing.OrganisationId = p.OrganisationId
+	existing.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	projects[id] = existing
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Transferred Project: %+v\n", existing)
+	json.NewEncoder(w).Encode(existing)
+}
+
+func handleGetBillingAccounts(w http.ResponseWriter, r *http.Request) {
+	costCentre := r.URL.Query().Get("cost_centre")
+
+	response := []BillingAccount{}
+	for _, b := range billingAccounts {
+		if costCentre == "" || b.CostCentre == costCentre {
+			response = append(response, b)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Billing Accounts: %+v\n", response)
+	json.NewEncoder(w).Encode(response)
+}
+
+func handleGetBillingAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := billingAccounts[id]; !ok {
+		http.Error(w, "Billing Account not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Billing Account: %+v\n", billingAccounts[id])
+	json.NewEncoder(w).Encode(billingAccounts[id])
+}
+
 func handlePostProjectMember(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	project_id := vars["project_id"]
@@ -136,15 +588,40 @@ func handlePostProjectMember(w http.ResponseWriter, r *http.Request) {
 
 	fmt.Printf("Debug Project Member: %+v\n", p)
 
-	p.Id = faker.UUIDHyphenated()
-	if p.UserId == "" {
-		p.UserId = faker.UUIDHyphenated()
+	if p.Role == "" {
+		p.Role = "member"
 	}
-	if p.EMail == "" {
-		p.EMail = faker.Email()
+	if !slices.Contains(projectMemberRoles, p.Role) {
+		http.Error(w, "Invalid role", http.StatusBadRequest)
+		return
 	}
 
-	p.DisplayName = faker.Name()
+	p.Id = faker.UUIDHyphenated()
+	if p.ServiceAccountId != nil {
+		// Service accounts have no user
+		sa, ok := serviceAccounts[*p.ServiceAccountId]
+		if !ok {
+			http.Error(w, "Service Account not found", http.StatusBadRequest)
+			return
+		}
+		p.DisplayName = sa.Name
+	} else if p.GroupId != nil {
+		// Groups have no user either
+		g, ok := groups[*p.GroupId]
+		if !ok {
+			http.Error(w, "Group not found", http.StatusBadRequest)
+			return
+		}
+		p.DisplayName = g.Name
+	} else {
+		if p.UserId == "" {
+			p.UserId = faker.UUIDHyphenated()
+		}
+		if p.EMail == "" {
+			p.EMail = faker.Email()
+		}
+		p.DisplayName = faker.Name()
+	}
 	p.ProjectId = project_id
 	p.CreatedAt = time.Now().Format(time.RFC3339)
 	p.UpdatedAt = time.Now().Format(time.RFC3339)
@@ -152,11 +629,14 @@ func handlePostProjectMember(w http.ResponseWriter, r *http.Request) {
 	projectMembers[p.Id] = p
 
 	response := ProjectMemberResponse{
-		Id:        projectMembers[p.Id].Id,
-		ProjectId: projectMembers[p.Id].ProjectId,
-		UserId:    projectMembers[p.Id].UserId,
-		CreatedAt: projectMembers[p.Id].CreatedAt,
-		UpdatedAt: projectMembers[p.Id].UpdatedAt,
+		Id:               projectMembers[p.Id].Id,
+		ProjectId:        projectMembers[p.Id].ProjectId,
+		UserId:           projectMembers[p.Id].UserId,
+		ServiceAccountId: projectMembers[p.Id].ServiceAccountId,
+		GroupId:          projectMembers[p.Id].GroupId,
+		Role:             projectMembers[p.Id].Role,
+		CreatedAt:        projectMembers[p.Id].CreatedAt,
+		UpdatedAt:        projectMembers[p.Id].UpdatedAt,
 		Links: ProjectMemberResponseLinks{
 			Project: "/api/v1/projects/" + projectMembers[p.Id].ProjectId,
 		},
@@ -189,11 +669,14 @@ func handleGetProjectMember(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := ProjectMemberResponse{
-		Id:        projectMembers[id].Id,
-		ProjectId: projectMembers[id].ProjectId,
-		UserId:    projectMembers[id].UserId,
-		CreatedAt: projectMembers[id].CreatedAt,
-		UpdatedAt: projectMembers[id].UpdatedAt,
+		Id:               projectMembers[id].Id,
+		ProjectId:        projectMembers[id].ProjectId,
+		UserId:           projectMembers[id].UserId,
+		ServiceAccountId: projectMembers[id].ServiceAccountId,
+		GroupId:          projectMembers[id].GroupId,
+		Role:             projectMembers[id].Role,
+		CreatedAt:        projectMembers[id].CreatedAt,
+		UpdatedAt:        projectMembers[id].UpdatedAt,
 		Links: ProjectMemberResponseLinks{
 			Project: "/api/v1/projects/" + projectMembers[id].ProjectId,
 		},
@@ -231,28 +714,1547 @@ func handleDeleteProjectMember(w http.ResponseWriter, r *http.Request) {
 	fmt.Printf("Deleted Project Member: %+v\n", id)
 }
 
-func main() {
+func handlePostProjectBudget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	project_id := vars["project_id"]
 
-	var p Project = Project{
-		Id:             "0faaecfb-d154-4f8f-bdc8-fccd630ddb39",
-		Name:           "test1",
-		Description:    nil,
-		OrganisationId: orgId,
-		Archived:       false,
-		ArchivedAt:     "",
-		CreatedAt:      "2024-01-01T00:00:00Z",
-		UpdatedAt:      "2024-01-01T00:00:00Z",
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var b ProjectBudget
+	err := decoder.Decode(&b)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	b.Id = faker.UUIDHyphenated()
+	b.ProjectId = project_id
+	b.CreatedAt = time.Now().Format(time.RFC3339)
+	b.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	projectBudgets[b.Id] = b
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Project Budget: %+v\n", b)
+	json.NewEncoder(w).Encode(b)
+}
+
+func handleGetProjectBudget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	if b, ok := projectBudgets[id]; !ok || b.ProjectId != project_id {
+		http.Error(w, "Project Budget not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Project Budget: %+v\n", projectBudgets[id])
+	json.NewEncoder(w).Encode(projectBudgets[id])
+}
+
+func handlePutProjectBudget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	existing, ok := projectBudgets[id]
+	if !ok || existing.ProjectId != project_id {
+		http.Error(w, "Project Budget not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var b ProjectBudget
+	err := decoder.Decode(&b)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	b.Id = existing.Id
+	b.ProjectId = existing.ProjectId
+	b.CreatedAt = existing.CreatedAt
+	b.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	projectBudgets[id] = b
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Project Budget: %+v\n", b)
+	json.NewEncoder(w).Encode(b)
+}
+
+func handleDeleteProjectBudget(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	if b, ok := projectBudgets[id]; !ok || b.ProjectId != project_id {
+		http.Error(w, "Project Budget not found", http.StatusNotFound)
+		return
+	}
+
+	delete(projectBudgets, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Project Budget: %+v\n", id)
+}
+
+// findProjectBudget checks that the budget of the request exists in its project.
+func findProjectBudget(w http.ResponseWriter, vars map[string]string) bool {
+	if _, ok := projects[vars["project_id"]]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return false
+	}
+
+	if b, ok := projectBudgets[vars["budget_id"]]; !ok || b.ProjectId != vars["project_id"] {
+		http.Error(w, "Project Budget not found", http.StatusNotFound)
+		return false
+	}
+
+	return true
+}
+
+// projectMemberRoles are the roles a member can have in a project.
+var projectMemberRoles = []string{"owner", "member", "reader"}
+
+// validMemberRoles checks that only project member roles are notified by a budget alert.
+func validMemberRoles(w http.ResponseWriter, roles []string) bool {
+	for _, role := range roles {
+		if !slices.Contains(projectMemberRoles, role) {
+			http.Error(w, "Invalid member role", http.StatusBadRequest)
+			return false
+		}
+	}
+	return true
+}
+
+func handlePostBudgetAlert(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	if !findProjectBudget(w, vars) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var a BudgetAlert
+	err := decoder.Decode(&a)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !validMemberRoles(w, a.MemberRoles) {
+		return
+	}
+
+	a.Id = faker.UUIDHyphenated()
+	a.ProjectId = vars["project_id"]
+	a.BudgetId = vars["budget_id"]
+	a.CreatedAt = time.Now().Format(time.RFC3339)
+	a.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	budgetAlerts[a.Id] = a
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Budget Alert: %+v\n", a)
+	json.NewEncoder(w).Encode(a)
+}
+
+func handleGetBudgetAlert(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+	if !findProjectBudget(w, vars) {
+		return
+	}
+
+	if a, ok := budgetAlerts[id]; !ok || a.BudgetId != vars["budget_id"] {
+		http.Error(w, "Budget Alert not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Budget Alert: %+v\n", budgetAlerts[id])
+	json.NewEncoder(w).Encode(budgetAlerts[id])
+}
+
+func handlePutBudgetAlert(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+	if !findProjectBudget(w, vars) {
+		return
+	}
+
+	existing, ok := budgetAlerts[id]
+	if !ok || existing.BudgetId != vars["budget_id"] {
+		http.Error(w, "Budget Alert not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var a BudgetAlert
+	err := decoder.Decode(&a)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !validMemberRoles(w, a.MemberRoles) {
+		return
+	}
+
+	a.Id = existing.Id
+	a.ProjectId = existing.ProjectId
+	a.BudgetId = existing.BudgetId
+	a.CreatedAt = existing.CreatedAt
+	a.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	budgetAlerts[id] = a
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Budget Alert: %+v\n", a)
+	json.NewEncoder(w).Encode(a)
+}
+
+func handleDeleteBudgetAlert(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+	if !findProjectBudget(w, vars) {
+		return
+	}
+
+	if a, ok := budgetAlerts[id]; !ok || a.BudgetId != vars["budget_id"] {
+		http.Error(w, "Budget Alert not found", http.StatusNotFound)
+		return
+	}
+
+	delete(budgetAlerts, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Budget Alert: %+v\n", id)
+}
+
+func handleGetProjectUsage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := projects[id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", r.URL.Query().Get("start_date"))
+	if err != nil {
+		http.Error(w, "Invalid start_date", http.StatusBadRequest)
+		return
+	}
+	end, err := time.Parse("2006-01-02", r.URL.Query().Get("end_date"))
+	if err != nil {
+		http.Error(w, "Invalid end_date", http.StatusBadRequest)
+		return
+	}
+
+	// Usage grows linearly with the number of days in the range
+	days := end.Sub(start).Hours()/24 + 1
+	usage := ProjectUsage{
+		ProjectId: id,
+		StartDate: start.Format("2006-01-02"),
+		EndDate:   end.Format("2006-01-02"),
+		Currency:  "CHF",
+		Usage: []ProjectUsageItem{
+			{Service: "compute", Region: "ZH", Quantity: 48 * days, Unit: "core_hours", Cost: 1.2 * days},
+			{Service: "volume", Region: "ZH", Quantity: 2400 * days, Unit: "gb_hours", Cost: 0.5 * days},
+			{Service: "object_storage", Region: "LS", Quantity: 1200 * days, Unit: "gb_hours", Cost: 0.25 * days},
+		},
+	}
+	for _, item := range usage.Usage {
+		usage.TotalCost += item.Cost
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Project Usage: %+v\n", usage)
+	json.NewEncoder(w).Encode(usage)
+}
+
+func handleGetRegions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Regions: %+v\n", regions)
+	json.NewEncoder(w).Encode(regions)
+}
+
+// findProjectQuota returns the quota of a project in a region, creating the default quota on first access.
+func findProjectQuota(w http.ResponseWriter, vars map[string]string) (ProjectQuota, bool) {
+	project_id := vars["project_id"]
+	region := vars["region"]
+
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return ProjectQuota{}, false
+	}
+
+	if !slices.ContainsFunc(regions, func(r Region) bool { return r.Id == region }) {
+		http.Error(w, "Region not found", http.StatusNotFound)
+		return ProjectQuota{}, false
+	}
+
+	q, ok := projectQuotas[project_id+"/"+region]
+	if !ok {
+		q = ProjectQuota{
+			ProjectId: project_id,
+			Region:    region,
+			Quota:     QuotaValues{Instances: 10, Cores: 20, RamMb: 51200, Volumes: 10, FloatingIps: 2, ObjectStorageGb: 100},
+			Usage:     QuotaValues{Instances: 2, Cores: 4, RamMb: 8192, Volumes: 3, FloatingIps: 1, ObjectStorageGb: 12},
+		}
+		projectQuotas[project_id+"/"+region] = q
+	}
+
+	return q, true
+}
+
+func handleGetProjectQuota(w http.ResponseWriter, r *http.Request) {
+	q, ok := findProjectQuota(w, mux.Vars(r))
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Project Quota: %+v\n", q)
+	json.NewEncoder(w).Encode(q)
+}
+
+func handlePostQuotaChangeRequest(w http.ResponseWriter, r *http.Request) {
+	q, ok := findProjectQuota(w, mux.Vars(r))
+	if !ok {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	var cr QuotaChangeCreateRequest
+	err := decoder.Decode(&cr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c := QuotaChangeRequest{
+		Id:        faker.UUIDHyphenated(),
+		Reason:    cr.Reason,
+		Quota:     cr.Quota,
+		CreatedAt: time.Now().Format(time.RFC3339),
+	}
+
+	// More than 1000 instances are rejected, more than 512 cores need manual approval,
+	// everything else is approved and applied immediately
+	switch {
+	case c.Quota.Instances != nil && *c.Quota.Instances > 1000:
+		reason := "Requested number of instances exceeds the regional capacity"
+		c.Status = "rejected"
+		c.Reason = &reason
+	case c.Quota.Cores != nil && *c.Quota.Cores > 512:
+		c.Status = "pending"
+	default:
+		c.Status = "approved"
+		apply := func(value *int64, current *int64) {
+			if value != nil {
+				*current = *value
+			}
+		}
+		apply(c.Quota.Instances, &q.Quota.Instances)
+		apply(c.Quota.Cores, &q.Quota.Cores)
+		apply(c.Quota.RamMb, &q.Quota.RamMb)
+		apply(c.Quota.Volumes, &q.Quota.Volumes)
+		apply(c.Quota.FloatingIps, &q.Quota.FloatingIps)
+		apply(c.Quota.ObjectStorageGb, &q.Quota.ObjectStorageGb)
+	}
+
+	q.ChangeRequest = &c
+	projectQuotas[q.ProjectId+"/"+q.Region] = q
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Quota Change Request: %+v\n", c)
+	json.NewEncoder(w).Encode(c)
+}
+
+func handlePostApplicationCredential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	project_id := vars["project_id"]
+
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var c ApplicationCredential
+	err := decoder.Decode(&c)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !slices.ContainsFunc(regions, func(r Region) bool { return r.Id == c.Region }) {
+		http.Error(w, "Region not found", http.StatusBadRequest)
+		return
+	}
+
+	if c.ExpiresAt != nil {
+		expiresAt, err := time.Parse(time.RFC3339, *c.ExpiresAt)
+		if err != nil || !expiresAt.After(time.Now()) {
+			http.Error(w, "expires_at must be in the future", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Like Keystone, application credential names are unique
+	for _, existing := range applicationCredentials {
+		if existing.ProjectId == project_id && existing.Name == c.Name {
+			http.Error(w, "Application credential with this name already exists", http.StatusConflict)
+			return
+		}
+	}
+
+	c.Id = strings.ReplaceAll(faker.UUIDHyphenated(), "-", "")
+	c.ProjectId = project_id
+	if len(c.Roles) == 0 {
+		c.Roles = []string{"member", "reader"}
+	}
+	if c.AccessRules == nil {
+		c.AccessRules = []ApplicationCredentialAccessRule{}
+	}
+	c.CreatedAt = time.Now().Format(time.RFC3339)
+
+	// The secret is only returned once
+	applicationCredentials[c.Id] = c
+	c.Secret = faker.Password()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Application Credential: %+v\n", c)
+	json.NewEncoder(w).Encode(c)
+}
+
+func handleGetApplicationCredential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	if c, ok := applicationCredentials[id]; !ok || c.ProjectId != project_id {
+		http.Error(w, "Application Credential not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Application Credential: %+v\n", applicationCredentials[id])
+	json.NewEncoder(w).Encode(applicationCredentials[id])
+}
+
+func handleDeleteApplicationCredential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if c, ok := applicationCredentials[id]; !ok || c.ProjectId != project_id {
+		http.Error(w, "Application Credential not found", http.StatusNotFound)
+		return
+	}
+
+	delete(applicationCredentials, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Application Credential: %+v\n", id)
+}
+
+func handlePostS3Credential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	project_id := vars["project_id"]
+
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var c S3Credential
+	err := decoder.Decode(&c)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.AccessKey = strings.ReplaceAll(faker.UUIDHyphenated(), "-", "")
+	c.ProjectId = project_id
+	if c.UserId == "" {
+		c.UserId = "4b7f2c9e-6a1d-4e83-b5f0-9c2d7e1a3f68"
+	}
+	c.CreatedAt = time.Now().Format(time.RFC3339)
+
+	// The secret key is only returned once
+	s3Credentials[c.AccessKey] = c
+	c.SecretKey = strings.ReplaceAll(faker.UUIDHyphenated(), "-", "")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created S3 Credential: %+v\n", c)
+	json.NewEncoder(w).Encode(c)
+}
+
+func handleGetS3Credential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if _, ok := projects[project_id]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	if c, ok := s3Credentials[id]; !ok || c.ProjectId != project_id {
+		http.Error(w, "S3 Credential not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get S3 Credential: %+v\n", s3Credentials[id])
+	json.NewEncoder(w).Encode(s3Credentials[id])
+}
+
+func handleDeleteS3Credential(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	project_id := vars["project_id"]
+	if c, ok := s3Credentials[id]; !ok || c.ProjectId != project_id {
+		http.Error(w, "S3 Credential not found", http.StatusNotFound)
+		return
+	}
+
+	delete(s3Credentials, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted S3 Credential: %+v\n", id)
+}
+
+func handlePostServiceAccount(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var sa ServiceAccount
+	err := decoder.Decode(&sa)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sa.Id = faker.UUIDHyphenated()
+	if sa.OrganisationId == "" {
+		sa.OrganisationId = orgId
+	}
+	sa.CreatedAt = time.Now().Format(time.RFC3339)
+	sa.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	serviceAccounts[sa.Id] = sa
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Service Account: %+v\n", sa)
+	json.NewEncoder(w).Encode(sa)
+}
+
+func handleGetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := serviceAccounts[id]; !ok {
+		http.Error(w, "Service Account not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Service Account: %+v\n", serviceAccounts[id])
+	json.NewEncoder(w).Encode(serviceAccounts[id])
+}
+
+func handlePutServiceAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := serviceAccounts[id]
+	if !ok {
+		http.Error(w, "Service Account not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var sa ServiceAccount
+	err := decoder.Decode(&sa)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	existing.Name = sa.Name
+	existing.Description = sa.Description
+	existing.Enabled = sa.Enabled
+	existing.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	serviceAccounts[id] = existing
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Service Account: %+v\n", existing)
+	json.NewEncoder(w).Encode(existing)
+}
+
+func handleDeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := serviceAccounts[id]; !ok {
+		http.Error(w, "Service Account not found", http.StatusNotFound)
+		return
+	}
+
+	delete(serviceAccounts, id)
+	for keyId, k := range serviceAccountKeys {
+		if k.ServiceAccountId == id {
+			delete(serviceAccountKeys, keyId)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Service Account: %+v\n", id)
+}
+
+func handlePostServiceAccountKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	service_account_id := vars["service_account_id"]
+
+	sa, ok := serviceAccounts[service_account_id]
+	if !ok {
+		http.Error(w, "Service Account not found", http.StatusNotFound)
+		return
+	}
+
+	if !sa.Enabled {
+		http.Error(w, "Service Account is disabled", http.StatusConflict)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var k ServiceAccountKey
+	err := decoder.Decode(&k)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if k.ExpiresAt != nil {
+		expiresAt, err := time.Parse(time.RFC3339, *k.ExpiresAt)
+		if err != nil || !expiresAt.After(time.Now()) {
+			http.Error(w, "expires_at must be in the future", http.StatusBadRequest)
+			return
+		}
+	}
+
+	k.Id = faker.UUIDHyphenated()
+	k.ServiceAccountId = service_account_id
+	if len(k.Scopes) == 0 {
+		k.Scopes = []string{"projects:read", "projects:write"}
+	}
+	k.CreatedAt = time.Now().Format(time.RFC3339)
+
+	// The token is only returned once
+	serviceAccountKeys[k.Id] = k
+	k.Token = "sc_" + strings.ReplaceAll(faker.UUIDHyphenated(), "-", "")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Service Account Key: %+v\n", k)
+	json.NewEncoder(w).Encode(k)
+}
+
+func handleGetServiceAccountKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if k, ok := serviceAccountKeys[id]; !ok || k.ServiceAccountId != vars["service_account_id"] {
+		http.Error(w, "Service Account Key not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Service Account Key: %+v\n", serviceAccountKeys[id])
+	json.NewEncoder(w).Encode(serviceAccountKeys[id])
+}
+
+func handleDeleteServiceAccountKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if k, ok := serviceAccountKeys[id]; !ok || k.ServiceAccountId != vars["service_account_id"] {
+		http.Error(w, "Service Account Key not found", http.StatusNotFound)
+		return
+	}
+
+	delete(serviceAccountKeys, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Service Account Key: %+v\n", id)
+}
+
+func handlePostGroup(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var g Group
+	err := decoder.Decode(&g)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	g.Id = faker.UUIDHyphenated()
+	if g.OrganisationId == "" {
+		g.OrganisationId = orgId
+	}
+	g.CreatedAt = time.Now().Format(time.RFC3339)
+	g.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	groups[g.Id] = g
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Group: %+v\n", g)
+	json.NewEncoder(w).Encode(g)
+}
+
+func handleGetGroup(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	g, ok := groups[id]
+	if !ok {
+		http.Error(w, "Group not found", http.StatusNotFound)
+		return
+	}
+
+	g = withMemberCount(g)
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Group: %+v\n", g)
+	json.NewEncoder(w).Encode(g)
+}
+
+func handlePutGroup(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := groups[id]
+	if !ok {
+		http.Error(w, "Group not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var g Group
+	err := decoder.Decode(&g)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	existing.Name = g.Name
+	existing.Description = g.Description
+	existing.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	groups[id] = existing
+	existing = withMemberCount(existing)
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Group: %+v\n", existing)
+	json.NewEncoder(w).Encode(existing)
+}
+
+// withMemberCount derives the member count of a group from its memberships
+func withMemberCount(g Group) Group {
+	g.MemberCount = 0
+	for _, m := range groupMembers {
+		if m.GroupId == g.Id {
+			g.MemberCount++
+		}
+	}
+	return g
+}
+
+func handleDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := groups[id]; !ok {
+		http.Error(w, "Group not found", http.StatusNotFound)
+		return
+	}
+
+	delete(groups, id)
+	for memberId, m := range groupMembers {
+		if m.GroupId == id {
+			delete(groupMembers, memberId)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Group: %+v\n", id)
+}
+
+func newGroupMemberResponse(m GroupMember) GroupMemberResponse {
+	return GroupMemberResponse{
+		Id:        m.Id,
+		GroupId:   m.GroupId,
+		UserId:    m.UserId,
+		CreatedAt: m.CreatedAt,
+		User: ProjectMemberResponseUser{
+			Id:          m.UserId,
+			Email:       m.EMail,
+			DisplayName: m.DisplayName,
+		},
+	}
+}
+
+func handlePostGroupMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	group_id := vars["group_id"]
+
+	if _, ok := groups[group_id]; !ok {
+		http.Error(w, "Group not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var m GroupMember
+	err := decoder.Decode(&m)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.Id = faker.UUIDHyphenated()
+	m.GroupId = group_id
+	if m.UserId == "" {
+		m.UserId = faker.UUIDHyphenated()
+	}
+	if m.EMail == "" {
+		m.EMail = faker.Email()
+	}
+	m.DisplayName = faker.Name()
+	m.CreatedAt = time.Now().Format(time.RFC3339)
+
+	groupMembers[m.Id] = m
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Group Member: %+v\n", m)
+	json.NewEncoder(w).Encode(newGroupMemberResponse(m))
+}
+
+func handleGetGroupMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	m, ok := groupMembers[id]
+	if !ok || m.GroupId != vars["group_id"] {
+		http.Error(w, "Group Member not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Group Member: %+v\n", m)
+	json.NewEncoder(w).Encode(newGroupMemberResponse(m))
+}
+
+func handleDeleteGroupMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if m, ok := groupMembers[id]; !ok || m.GroupId != vars["group_id"] {
+		http.Error(w, "Group Member not found", http.StatusNotFound)
+		return
+	}
+
+	delete(groupMembers, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Group Member: %+v\n", id)
+}
+
+func newOrganisationMemberResponse(m OrganisationMember) OrganisationMemberResponse {
+	return OrganisationMemberResponse{
+		Id:             m.Id,
+		OrganisationId: m.OrganisationId,
+		UserId:         m.UserId,
+		Role:           m.Role,
+		CreatedAt:      m.CreatedAt,
+		User: ProjectMemberResponseUser{
+			Id:          m.UserId,
+			Email:       m.EMail,
+			DisplayName: m.DisplayName,
+		},
+	}
+}
+
+func handleGetOrganisationMembers(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	organisation_id := vars["organisation_id"]
+	role := r.URL.Query().Get("role")
+
+	response := []OrganisationMemberResponse{}
+	for _, m := range organisationMembers {
+		if m.OrganisationId != organisation_id || (role != "" && m.Role != role) {
+			continue
+		}
+		response = append(response, newOrganisationMemberResponse(m))
+	}
+	slices.SortFunc(response, func(a, b OrganisationMemberResponse) int {
+		return strings.Compare(a.CreatedAt+a.User.Email, b.CreatedAt+b.User.Email)
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Organisation Members: %+v\n", response)
+	json.NewEncoder(w).Encode(response)
+}
+
+func handlePostOrganisationMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	decoder := json.NewDecoder(r.Body)
+	var m OrganisationMember
+	err := decoder.Decode(&m)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !slices.Contains([]string{"admin", "billing", "member"}, m.Role) {
+		http.Error(w, "Invalid role", http.StatusBadRequest)
+		return
+	}
+
+	m.Id = faker.UUIDHyphenated()
+	m.OrganisationId = vars["organisation_id"]
+	if m.UserId == "" {
+		m.UserId = faker.UUIDHyphenated()
+	}
+	if m.EMail == "" {
+		m.EMail = faker.Email()
+	}
+	m.DisplayName = faker.Name()
+	m.CreatedAt = time.Now().Format(time.RFC3339)
+
+	organisationMembers[m.Id] = m
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Organisation Member: %+v\n", m)
+	json.NewEncoder(w).Encode(newOrganisationMemberResponse(m))
+}
+
+func handleGetOrganisationMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	m, ok := organisationMembers[id]
+	if !ok || m.OrganisationId != vars["organisation_id"] {
+		http.Error(w, "Organisation Member not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Organisation Member: %+v\n", m)
+	json.NewEncoder(w).Encode(newOrganisationMemberResponse(m))
+}
+
+func handlePutOrganisationMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := organisationMembers[id]
+	if !ok || existing.OrganisationId != vars["organisation_id"] {
+		http.Error(w, "Organisation Member not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var m OrganisationMember
+	err := decoder.Decode(&m)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !slices.Contains([]string{"admin", "billing", "member"}, m.Role) {
+		http.Error(w, "Invalid role", http.StatusBadRequest)
+		return
+	}
+
+	existing.Role = m.Role
+	organisationMembers[id] = existing
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Organisation Member: %+v\n", existing)
+	json.NewEncoder(w).Encode(newOrganisationMemberResponse(existing))
+}
+
+func handleDeleteOrganisationMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if m, ok := organisationMembers[id]; !ok || m.OrganisationId != vars["organisation_id"] {
+		http.Error(w, "Organisation Member not found", http.StatusNotFound)
+		return
+	}
+
+	delete(organisationMembers, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Organisation Member: %+v\n", id)
+}
+
+// newWebhookResponse hides the signing secret, which the API never returns.
+func newWebhookResponse(wh Webhook) Webhook {
+	wh.SigningSecret = ""
+	return wh
+}
+
+func handlePostWebhook(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var wh Webhook
+	err := decoder.Decode(&wh)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	wh.Id = faker.UUIDHyphenated()
+	if wh.OrganisationId == "" {
+		wh.OrganisationId = orgId
+	}
+	wh.CreatedAt = time.Now().Format(time.RFC3339)
+	wh.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	webhooks[wh.Id] = wh
+
+	// Every new webhook receives a ping
+	responseCode := int64(http.StatusOK)
+	d := WebhookDelivery{
+		Id:           faker.UUIDHyphenated(),
+		WebhookId:    wh.Id,
+		Event:        "webhook.ping",
+		Status:       "succeeded",
+		ResponseCode: &responseCode,
+		Attempts:     1,
+		CreatedAt:    wh.CreatedAt,
+	}
+	webhookDeliveries[d.Id] = d
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Webhook: %+v\n", wh)
+	json.NewEncoder(w).Encode(newWebhookResponse(wh))
+}
+
+func handleGetWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := webhooks[id]; !ok {
+		http.Error(w, "Webhook not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Webhook: %+v\n", webhooks[id])
+	json.NewEncoder(w).Encode(newWebhookResponse(webhooks[id]))
+}
+
+func handlePutWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := webhooks[id]
+	if !ok {
+		http.Error(w, "Webhook not found", http.StatusNotFound)
+		return
+	}
+
+	// A missing signing secret keeps the current one, an empty one clears it
+	decoder := json.NewDecoder(r.Body)
+	var wh struct {
+		Webhook
+		SigningSecret *string `json:"signing_secret"`
+	}
+	err := decoder.Decode(&wh)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	existing.Url = wh.Url
+	existing.Events = wh.Events
+	existing.Enabled = wh.Enabled
+	if wh.SigningSecret != nil {
+		existing.SigningSecret = *wh.SigningSecret
+	}
+	existing.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	webhooks[id] = existing
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated Webhook: %+v\n", existing)
+	json.NewEncoder(w).Encode(newWebhookResponse(existing))
+}
+
+func handleDeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := webhooks[id]; !ok {
+		http.Error(w, "Webhook not found", http.StatusNotFound)
+		return
+	}
+
+	delete(webhooks, id)
+	for deliveryId, d := range webhookDeliveries {
+		if d.WebhookId == id {
+			delete(webhookDeliveries, deliveryId)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted Webhook: %+v\n", id)
+}
+
+func handleGetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := webhooks[id]; !ok {
+		http.Error(w, "Webhook not found", http.StatusNotFound)
+		return
+	}
+
+	limit := 20
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if _, err := fmt.Sscanf(l, "%d", &limit); err != nil || limit < 1 || limit > 100 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	result := []WebhookDelivery{}
+	for _, d := range webhookDeliveries {
+		if d.WebhookId == id {
+			result = append(result, d)
+		}
+	}
+
+	// Newest deliveries first
+	slices.SortFunc(result, func(a, b WebhookDelivery) int {
+		return strings.Compare(b.CreatedAt, a.CreatedAt)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Webhook Deliveries: %+v\n", result)
+	json.NewEncoder(w).Encode(result)
+}
+
+func handleGetAuditEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("organisation_id") == "" && query.Get("project_id") == "" {
+		http.Error(w, "organisation_id or project_id is required", http.StatusBadRequest)
+		return
+	}
+
+	pageSize := 100
+	if s := query.Get("page_size"); s != "" {
+		if _, err := fmt.Sscanf(s, "%d", &pageSize); err != nil || pageSize < 1 {
+			http.Error(w, "Invalid page_size", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// The page token is the offset of the first event of the page
+	offset := 0
+	if s := query.Get("page_token"); s != "" {
+		if _, err := fmt.Sscanf(s, "%d", &offset); err != nil || offset < 0 {
+			http.Error(w, "Invalid page_token", http.StatusBadRequest)
+			return
+		}
+	}
+
+	result := []AuditEvent{}
+	for _, e := range auditEvents {
+		if o := query.Get("organisation_id"); o != "" && e.OrganisationId != o {
+			continue
+		}
+		if p := query.Get("project_id"); p != "" && (e.ProjectId == nil || *e.ProjectId != p) {
+			continue
+		}
+		if t := query.Get("start_time"); t != "" && e.OccurredAt < t {
+			continue
+		}
+		if t := query.Get("end_time"); t != "" && e.OccurredAt >= t {
+			continue
+		}
+		if a := query.Get("actor"); a != "" && e.ActorId != a && e.ActorEMail != a {
+			continue
+		}
+		if t := query.Get("event_type"); t != "" && e.EventType != t {
+			continue
+		}
+		result = append(result, e)
+	}
+
+	page := AuditEventPage{Events: []AuditEvent{}}
+	if offset < len(result) {
+		end := min(offset+pageSize, len(result))
+		page.Events = result[offset:end]
+		if end < len(result) {
+			page.NextPageToken = fmt.Sprint(end)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Audit Events: %d of %d from %d\n", len(page.Events), len(result), offset)
+	json.NewEncoder(w).Encode(page)
+}
+
+func handlePostSshKey(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var k SshKey
+	err := decoder.Decode(&k)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// The comment of the key is dropped like the real API does
+	fields := strings.Fields(k.PublicKey)
+	if len(fields) < 2 {
+		http.Error(w, "Invalid public key", http.StatusBadRequest)
+		return
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		http.Error(w, "Invalid public key", http.StatusBadRequest)
+		return
+	}
+	hash := sha256.Sum256(blob)
+
+	if k.ProjectId != nil && *k.ProjectId != "" {
+		if _, ok := projects[*k.ProjectId]; !ok {
+			http.Error(w, "Project not found", http.StatusNotFound)
+			return
+		}
+	} else {
+		k.ProjectId = nil
+	}
+
+	k.Id = faker.UUIDHyphenated()
+	k.PublicKey = fields[0] + " " + fields[1]
+	k.Fingerprint = "SHA256:" + base64.RawStdEncoding.EncodeToString(hash[:])
+	k.CreatedAt = time.Now().Format(time.RFC3339)
+	k.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	sshKeys[k.Id] = k
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created SSH Key: %+v\n", k)
+	json.NewEncoder(w).Encode(k)
+}
+
+func handleGetSshKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := sshKeys[id]; !ok {
+		http.Error(w, "SSH Key not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get SSH Key: %+v\n", sshKeys[id])
+	json.NewEncoder(w).Encode(sshKeys[id])
+}
+
+func handlePutSshKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	existing, ok := sshKeys[id]
+	if !ok {
+		http.Error(w, "SSH Key not found", http.StatusNotFound)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var k SshKey
+	err := decoder.Decode(&k)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	existing.Name = k.Name
+	existing.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	sshKeys[id] = existing
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Updated SSH Key: %+v\n", existing)
+	json.NewEncoder(w).Encode(existing)
+}
+
+func handleDeleteSshKey(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := sshKeys[id]; !ok {
+		http.Error(w, "SSH Key not found", http.StatusNotFound)
+		return
+	}
+
+	delete(sshKeys, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Deleted SSH Key: %+v\n", id)
+}
+
+func handlePostSupportRequest(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var sr SupportRequest
+	err := decoder.Decode(&sr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := projects[sr.ProjectId]; !ok {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	sr.Id = faker.UUIDHyphenated()
+	sr.Status = "open"
+	supportRequestCount++
+	sr.ReferenceNumber = fmt.Sprintf("SR-%d-%06d", time.Now().Year(), supportRequestCount)
+	sr.Resolution = nil
+	sr.CreatedAt = time.Now().Format(time.RFC3339)
+	sr.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	supportRequests[sr.Id] = sr
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	fmt.Printf("Created Support Request: %+v\n", sr)
+	json.NewEncoder(w).Encode(sr)
+}
+
+func handleGetSupportRequests(w http.ResponseWriter, r *http.Request) {
+	referenceNumber := r.URL.Query().Get("reference_number")
+
+	result := []SupportRequest{}
+	for _, sr := range supportRequests {
+		if referenceNumber == "" || sr.ReferenceNumber == referenceNumber {
+			result = append(result, sr)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Support Requests: %+v\n", result)
+	json.NewEncoder(w).Encode(result)
+}
+
+func handleGetSupportRequest(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := supportRequests[id]; !ok {
+		http.Error(w, "Support Request not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Printf("Get Support Request: %+v\n", supportRequests[id])
+	json.NewEncoder(w).Encode(supportRequests[id])
+}
+
+func handleDeleteSupportRequest(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+
+	if _, ok := supportRequests[id]; !ok {
+		http.Error(w, "Support Request not found", http.StatusNotFound)
+		return
+	}
+
+	delete(supportRequests, id)
+
+	w.WriteHeader(http.StatusNoContent)
+	fmt.Printf("Withdrew Support Request: %+v\n", id)
+}
+
+func main() {
+
+	var p Project = Project{
+		Id:             "0faaecfb-d154-4f8f-bdc8-fccd630ddb39",
+		Name:           "test1",
+		Description:    nil,
+		OrganisationId: orgId,
+		Labels:         map[string]string{"department": "Informatikdienste"},
+		OpenstackProjects: map[string]OpenstackProject{
+			"ZH": {ProjectId: "5b9e3c1f0a7d4e2b8c6f1a3d9e7b5c2a", Domain: "Default", AuthUrl: "https://zh.cloud.switch.ch:5000/v3"},
+			"LS": {ProjectId: "8d2a6f4c1e9b4a7d3c5e0f2b6a8d1c4e", Domain: "Default", AuthUrl: "https://ls.cloud.switch.ch:5000/v3"},
+		},
+		Archived:   false,
+		ArchivedAt: "",
+		CreatedAt:  "2024-01-01T00:00:00Z",
+		UpdatedAt:  "2024-01-01T00:00:00Z",
+	}
+	projects[p.Id] = p
+
+	for _, b := range []BillingAccount{
+		{Id: "9d0c6f1e-8a43-4d38-9f5e-2b7c1e0a4f11", CostCentre: "1234-5678", Name: "Institute of Computer Science", OrganisationId: orgId, Active: true},
+		{Id: "3f6e2a7b-1c94-4b0d-8e2f-7a5d9c3b1e22", CostCentre: "8765-4321", Name: "Institute of Geography", OrganisationId: orgId, Active: true},
+	} {
+		billingAccounts[b.Id] = b
+	}
+
+	resolution := "GPU flavours are available in region ZH."
+	supportRequests["6f1d2c3b-4a5e-4f6d-8c7b-9a0e1d2c3b4a"] = SupportRequest{
+		Id:              "6f1d2c3b-4a5e-4f6d-8c7b-9a0e1d2c3b4a",
+		ProjectId:       p.Id,
+		Category:        "gpu_access",
+		Subject:         "Access to GPU flavours",
+		Body:            "We need GPU flavours for training models.",
+		Status:          "approved",
+		ReferenceNumber: "SR-2024-000042",
+		Resolution:      &resolution,
+		CreatedAt:       "2024-02-01T00:00:00Z",
+		UpdatedAt:       "2024-02-03T00:00:00Z",
+	}
+
+	// Two actors adding and removing members of the seeded project, one event per hour of January 2025
+	for i := 0; i < 250; i++ {
+		e := AuditEvent{
+			Id:             faker.UUIDHyphenated(),
+			EventType:      "project.member_added",
+			ActorId:        "2c1f6a3e-5d7b-4f8a-9e0c-1b2d3e4f5a6b",
+			ActorEMail:     "alice@example.org",
+			OrganisationId: orgId,
+			ProjectId:      &p.Id,
+			TargetType:     "project_member",
+			TargetId:       faker.UUIDHyphenated(),
+			Details:        map[string]string{"email": faker.Email()},
+			OccurredAt:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(i) * time.Hour).Format(time.RFC3339),
+		}
+		if i%2 == 1 {
+			e.EventType = "project.member_removed"
+			e.ActorId = "7e8f9a0b-1c2d-4e3f-8a5b-6c7d8e9f0a1b"
+			e.ActorEMail = "bob@example.org"
+		}
+		auditEvents = append(auditEvents, e)
 	}
-	projects[p.Id] = p
 
 	r := mux.NewRouter()
 
 	r.HandleFunc("/debug", handleDebug).Methods("GET")
 	r.HandleFunc("/api/v1/projects", handlePostProject).Methods("POST")
 	r.HandleFunc("/api/v1/projects/{id}", handleGetProject).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{id}", handlePutProject).Methods("PUT")
+	r.HandleFunc("/api/v1/projects/{id}/transfer", handlePostProjectTransfer).Methods("POST")
+	r.HandleFunc("/api/v1/projects/{id}/usage", handleGetProjectUsage).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{project_id}/quotas/{region}", handleGetProjectQuota).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{project_id}/quotas/{region}/requests", handlePostQuotaChangeRequest).Methods("POST")
+	r.HandleFunc("/api/v1/regions", handleGetRegions).Methods("GET")
+	r.HandleFunc("/api/v1/billing-accounts", handleGetBillingAccounts).Methods("GET")
+	r.HandleFunc("/api/v1/billing-accounts/{id}", handleGetBillingAccount).Methods("GET")
+	r.HandleFunc("/api/v1/service-accounts", handlePostServiceAccount).Methods("POST")
+	r.HandleFunc("/api/v1/service-accounts/{id}", handleGetServiceAccount).Methods("GET")
+	r.HandleFunc("/api/v1/service-accounts/{id}", handlePutServiceAccount).Methods("PUT")
+	r.HandleFunc("/api/v1/service-accounts/{id}", handleDeleteServiceAccount).Methods("DELETE")
+	r.HandleFunc("/api/v1/service-accounts/{service_account_id}/keys", handlePostServiceAccountKey).Methods("POST")
+	r.HandleFunc("/api/v1/service-accounts/{service_account_id}/keys/{id}", handleGetServiceAccountKey).Methods("GET")
+	r.HandleFunc("/api/v1/service-accounts/{service_account_id}/keys/{id}", handleDeleteServiceAccountKey).Methods("DELETE")
+	r.HandleFunc("/api/v1/organisations/{organisation_id}/members", handleGetOrganisationMembers).Methods("GET")
+	r.HandleFunc("/api/v1/organisations/{organisation_id}/members", handlePostOrganisationMember).Methods("POST")
+	r.HandleFunc("/api/v1/organisations/{organisation_id}/members/{id}", handleGetOrganisationMember).Methods("GET")
+	r.HandleFunc("/api/v1/organisations/{organisation_id}/members/{id}", handlePutOrganisationMember).Methods("PUT")
+	r.HandleFunc("/api/v1/organisations/{organisation_id}/members/{id}", handleDeleteOrganisationMember).Methods("DELETE")
+	r.HandleFunc("/api/v1/groups", handlePostGroup).Methods("POST")
+	r.HandleFunc("/api/v1/groups/{id}", handleGetGroup).Methods("GET")
+	r.HandleFunc("/api/v1/groups/{id}", handlePutGroup).Methods("PUT")
+	r.HandleFunc("/api/v1/groups/{id}", handleDeleteGroup).Methods("DELETE")
+	r.HandleFunc("/api/v1/groups/{group_id}/members", handlePostGroupMember).Methods("POST")
+	r.HandleFunc("/api/v1/groups/{group_id}/members/{id}", handleGetGroupMember).Methods("GET")
+	r.HandleFunc("/api/v1/groups/{group_id}/members/{id}", handleDeleteGroupMember).Methods("DELETE")
+	r.HandleFunc("/api/v1/audit-events", handleGetAuditEvents).Methods("GET")
+	r.HandleFunc("/api/v1/ssh-keys", handlePostSshKey).Methods("POST")
+	r.HandleFunc("/api/v1/support-requests", handlePostSupportRequest).Methods("POST")
+	r.HandleFunc("/api/v1/support-requests", handleGetSupportRequests).Methods("GET")
+	r.HandleFunc("/api/v1/support-requests/{id}", handleGetSupportRequest).Methods("GET")
+	r.HandleFunc("/api/v1/support-requests/{id}", handleDeleteSupportRequest).Methods("DELETE")
+	r.HandleFunc("/api/v1/ssh-keys/{id}", handleGetSshKey).Methods("GET")
+	r.HandleFunc("/api/v1/ssh-keys/{id}", handlePutSshKey).Methods("PUT")
+	r.HandleFunc("/api/v1/ssh-keys/{id}", handleDeleteSshKey).Methods("DELETE")
+	r.HandleFunc("/api/v1/webhooks", handlePostWebhook).Methods("POST")
+	r.HandleFunc("/api/v1/webhooks/{id}", handleGetWebhook).Methods("GET")
+	r.HandleFunc("/api/v1/webhooks/{id}", handlePutWebhook).Methods("PUT")
+	r.HandleFunc("/api/v1/webhooks/{id}", handleDeleteWebhook).Methods("DELETE")
+	r.HandleFunc("/api/v1/webhooks/{id}/deliveries", handleGetWebhookDeliveries).Methods("GET")
 	r.HandleFunc("/api/v1/projects/{project_id}/members", handlePostProjectMember).Methods("POST")
 	r.HandleFunc("/api/v1/projects/{project_id}/members/{id}", handleGetProjectMember).Methods("GET")
 	r.HandleFunc("/api/v1/projects/{project_id}/members/{id}", handleDeleteProjectMember).Methods("DELETE")
+	r.HandleFunc("/api/v1/projects/{project_id}/application-credentials", handlePostApplicationCredential).Methods("POST")
+	r.HandleFunc("/api/v1/projects/{project_id}/application-credentials/{id}", handleGetApplicationCredential).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{project_id}/application-credentials/{id}", handleDeleteApplicationCredential).Methods("DELETE")
+	r.HandleFunc("/api/v1/projects/{project_id}/s3-credentials", handlePostS3Credential).Methods("POST")
+	r.HandleFunc("/api/v1/projects/{project_id}/s3-credentials/{id}", handleGetS3Credential).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{project_id}/s3-credentials/{id}", handleDeleteS3Credential).Methods("DELETE")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets", handlePostProjectBudget).Methods("POST")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{id}", handleGetProjectBudget).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{id}", handlePutProjectBudget).Methods("PUT")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{id}", handleDeleteProjectBudget).Methods("DELETE")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{budget_id}/alerts", handlePostBudgetAlert).Methods("POST")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{budget_id}/alerts/{id}", handleGetBudgetAlert).Methods("GET")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{budget_id}/alerts/{id}", handlePutBudgetAlert).Methods("PUT")
+	r.HandleFunc("/api/v1/projects/{project_id}/budgets/{budget_id}/alerts/{id}", handleDeleteBudgetAlert).Methods("DELETE")
 
 	err := http.ListenAndServe(":3000", r)
 	if errors.Is(err, http.ErrServerClosed) {